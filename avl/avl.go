@@ -0,0 +1,281 @@
+package avl
+
+import (
+	"cmp"
+	"errors"
+
+	"github.com/dkaslovsky/search-structures/queue"
+)
+
+// Errors returned from an Avl
+var (
+	ErrEmpty        error = errors.New("Avl is empty")
+	ErrKeyNotFound  error = errors.New("key not found in Avl")
+	ErrIteratorStop error = errors.New("iterator stopped after iterating all nodes")
+)
+
+// Avl is a self-balancing binary search tree in which the heights of the two child subtrees of
+// every node differ by at most one
+type Avl[K any, V any] struct {
+	Tree *Node[K, V]
+	cmp  func(a, b K) int
+}
+
+// NewAvl constructs an empty Avl ordered by the natural ordering of its keys
+func NewAvl[K cmp.Ordered, V any]() *Avl[K, V] {
+	return NewAvlFunc[K, V](cmp.Compare[K])
+}
+
+// NewAvlFunc constructs an empty Avl ordered by a comparison function that returns a negative
+// number when a < b, a positive number when a > b, and zero when a == b
+func NewAvlFunc[K any, V any](cmp func(a, b K) int) *Avl[K, V] {
+	return &Avl[K, V]{
+		cmp: cmp,
+	}
+}
+
+// Node is a node of an Avl indexed by Key containing value Val
+type Node[K any, V any] struct {
+	Key    K
+	Val    V
+	Left   *Node[K, V]
+	Right  *Node[K, V]
+	height int
+}
+
+func newNode[K any, V any](key K, val V) *Node[K, V] {
+	return &Node[K, V]{
+		Key:    key,
+		Val:    val,
+		height: 1,
+	}
+}
+
+// Height returns the number of nodes on the longest path from a node to a leaf
+func (n *Node[K, V]) Height() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// BalanceFactor returns the height of a node's left subtree minus the height of its right subtree
+func (n *Node[K, V]) BalanceFactor() int {
+	if n == nil {
+		return 0
+	}
+	return n.Left.Height() - n.Right.Height()
+}
+
+// IsEmpty evaluates if an Avl is empty
+func (a *Avl[K, V]) IsEmpty() bool {
+	return a.Tree == nil
+}
+
+// Insert inserts a key/value pair
+func (a *Avl[K, V]) Insert(key K, val V) {
+	a.Tree = a.insert(a.Tree, key, val)
+}
+
+// Delete deletes a key/value pair
+func (a *Avl[K, V]) Delete(key K) error {
+	if a.IsEmpty() {
+		return ErrEmpty
+	}
+
+	tree, found := a.delete(a.Tree, key)
+	if !found {
+		return ErrKeyNotFound
+	}
+	a.Tree = tree
+	return nil
+}
+
+// Search searches an Avl for a key
+func (a *Avl[K, V]) Search(key K) (val V, found bool) {
+	curTree := a.Tree
+	for curTree != nil {
+		c := a.cmp(key, curTree.Key)
+		if c == 0 {
+			return curTree.Val, true
+		}
+		if c < 0 {
+			curTree = curTree.Left
+			continue
+		}
+		curTree = curTree.Right
+	}
+	return val, false
+}
+
+// Validate determines if an Avl satisfies both the binary search tree property and the AVL height
+// invariant
+func (a *Avl[K, V]) Validate() (bool, error) {
+	if a.IsEmpty() {
+		return false, ErrEmpty
+	}
+
+	_, valid := a.validate(a.Tree, nil, nil)
+	return valid, nil
+}
+
+// Iterator creates a function to iterate the nodes of the Avl by returning the next (breadth-first) node on each call
+func (a *Avl[K, V]) Iterator() func() (*Node[K, V], error) {
+	if a.IsEmpty() {
+		return func() (*Node[K, V], error) {
+			return nil, ErrIteratorStop
+		}
+	}
+
+	q := queue.NewQueue[*Node[K, V]]()
+	q.Push(a.Tree)
+	return func() (*Node[K, V], error) {
+		curA, err := q.Pop()
+		if err == queue.ErrEmptyQueue {
+			return nil, ErrIteratorStop
+		}
+		if curA.Left != nil {
+			q.Push(curA.Left)
+		}
+		if curA.Right != nil {
+			q.Push(curA.Right)
+		}
+		return curA, nil
+	}
+}
+
+// insert inserts a key/value pair into the subtree rooted at n and returns the rebalanced subtree
+func (a *Avl[K, V]) insert(n *Node[K, V], key K, val V) *Node[K, V] {
+	if n == nil {
+		return newNode(key, val)
+	}
+
+	c := a.cmp(key, n.Key)
+	if c == 0 {
+		// allow an existing value to be overwritten
+		n.Val = val
+		return n
+	}
+	if c < 0 {
+		n.Left = a.insert(n.Left, key, val)
+	} else {
+		n.Right = a.insert(n.Right, key, val)
+	}
+	return n.rebalance()
+}
+
+// delete deletes a key from the subtree rooted at n and returns the rebalanced subtree and success bool
+func (a *Avl[K, V]) delete(n *Node[K, V], key K) (*Node[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var found bool
+	c := a.cmp(key, n.Key)
+	switch {
+	case c < 0:
+		n.Left, found = a.delete(n.Left, key)
+	case c > 0:
+		n.Right, found = a.delete(n.Right, key)
+	default:
+		found = true
+		if n.Left == nil {
+			return n.Right, true
+		}
+		if n.Right == nil {
+			return n.Left, true
+		}
+		// overwrite with the leftmost (min) key/value of the right branch and delete it from there
+		left := n.Right.findLeftMost()
+		n.Key = left.Key
+		n.Val = left.Val
+		n.Right, _ = a.delete(n.Right, left.Key)
+	}
+	if !found {
+		return n, false
+	}
+	return n.rebalance(), true
+}
+
+// validate checks the subtree rooted at n against exclusive key bounds, where a nil bound indicates
+// that the subtree is unbounded on that side, and returns the subtree's height and validity
+func (a *Avl[K, V]) validate(n *Node[K, V], minKey *K, maxKey *K) (int, bool) {
+	if n == nil {
+		return 0, true
+	}
+	if minKey != nil && a.cmp(n.Key, *minKey) <= 0 {
+		return 0, false
+	}
+	if maxKey != nil && a.cmp(n.Key, *maxKey) >= 0 {
+		return 0, false
+	}
+
+	leftHeight, valid := a.validate(n.Left, minKey, &n.Key)
+	if !valid {
+		return 0, false
+	}
+	rightHeight, valid := a.validate(n.Right, &n.Key, maxKey)
+	if !valid {
+		return 0, false
+	}
+
+	height := max(leftHeight, rightHeight) + 1
+	if height != n.height {
+		return 0, false
+	}
+	if balance := leftHeight - rightHeight; balance < -1 || balance > 1 {
+		return 0, false
+	}
+	return height, true
+}
+
+// rebalance restores the AVL height invariant at n, assuming both of its subtrees satisfy it, and
+// returns the root of the resulting subtree
+func (n *Node[K, V]) rebalance() *Node[K, V] {
+	n.updateHeight()
+
+	balance := n.BalanceFactor()
+	if balance > 1 {
+		if n.Left.BalanceFactor() < 0 {
+			n.Left = n.Left.rotateLeft()
+		}
+		return n.rotateRight()
+	}
+	if balance < -1 {
+		if n.Right.BalanceFactor() > 0 {
+			n.Right = n.Right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+func (n *Node[K, V]) rotateLeft() *Node[K, V] {
+	right := n.Right
+	n.Right = right.Left
+	right.Left = n
+	n.updateHeight()
+	right.updateHeight()
+	return right
+}
+
+func (n *Node[K, V]) rotateRight() *Node[K, V] {
+	left := n.Left
+	n.Left = left.Right
+	left.Right = n
+	n.updateHeight()
+	left.updateHeight()
+	return left
+}
+
+func (n *Node[K, V]) updateHeight() {
+	n.height = max(n.Left.Height(), n.Right.Height()) + 1
+}
+
+func (n *Node[K, V]) findLeftMost() *Node[K, V] {
+	left := n
+	for left.Left != nil {
+		left = left.Left
+	}
+	return left
+}
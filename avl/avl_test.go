@@ -0,0 +1,300 @@
+package avl
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testNode struct {
+	key int
+	val string
+}
+
+func iterate(tree *Avl[int, string]) []testNode {
+	nodes := []testNode{}
+	iter := tree.Iterator()
+	for {
+		node, err := iter()
+		if err == ErrIteratorStop {
+			return nodes
+		}
+		nodes = append(nodes, testNode{node.Key, node.Val})
+	}
+}
+
+func TestInsert(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys            []int
+		expectedIteratedNodes []testNode
+	}{
+		"single insert": {
+			insertKeys:            []int{10},
+			expectedIteratedNodes: []testNode{{10, "val10"}},
+		},
+		"left left case": {
+			insertKeys: []int{30, 20, 10},
+			expectedIteratedNodes: []testNode{
+				{20, "val20"},
+				{10, "val10"},
+				{30, "val30"},
+			},
+		},
+		"right right case": {
+			insertKeys: []int{10, 20, 30},
+			expectedIteratedNodes: []testNode{
+				{20, "val20"},
+				{10, "val10"},
+				{30, "val30"},
+			},
+		},
+		"left right case": {
+			insertKeys: []int{30, 10, 20},
+			expectedIteratedNodes: []testNode{
+				{20, "val20"},
+				{10, "val10"},
+				{30, "val30"},
+			},
+		},
+		"right left case": {
+			insertKeys: []int{10, 30, 20},
+			expectedIteratedNodes: []testNode{
+				{20, "val20"},
+				{10, "val10"},
+				{30, "val30"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := NewAvl[int, string]()
+			for _, key := range test.insertKeys {
+				tree.Insert(key, valFor(key))
+			}
+			a.Equal(test.expectedIteratedNodes, iterate(tree))
+
+			valid, err := tree.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestInsertOverwrite(t *testing.T) {
+	a := assert.New(t)
+	tree := NewAvl[int, string]()
+	tree.Insert(10, "val10")
+	tree.Insert(10, "newVal10")
+	a.Equal([]testNode{{10, "newVal10"}}, iterate(tree))
+}
+
+func TestInsertSorted(t *testing.T) {
+	a := assert.New(t)
+	n := 1000
+	tree := NewAvl[int, string]()
+	for i := 0; i < n; i++ {
+		tree.Insert(i, valFor(i))
+	}
+
+	valid, err := tree.Validate()
+	a.NoError(err)
+	a.True(valid)
+
+	// the height of an AVL tree is bounded by approximately 1.44*log2(n)
+	a.LessOrEqual(float64(tree.Tree.Height()), 1.44*math.Log2(float64(n+2)))
+}
+
+func TestSearch(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys     []int
+		searchKey      int
+		expectedExists bool
+	}{
+		"empty tree": {
+			insertKeys:     []int{},
+			searchKey:      1,
+			expectedExists: false,
+		},
+		"tree without searchKey": {
+			insertKeys:     []int{10, 8, 12},
+			searchKey:      2,
+			expectedExists: false,
+		},
+		"tree with searchKey": {
+			insertKeys:     []int{10, 8, 12},
+			searchKey:      12,
+			expectedExists: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := NewAvl[int, string]()
+			for _, key := range test.insertKeys {
+				tree.Insert(key, valFor(key))
+			}
+			val, exists := tree.Search(test.searchKey)
+			a.Equal(test.expectedExists, exists)
+			if !test.expectedExists {
+				return
+			}
+			a.Equal(valFor(test.searchKey), val)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys            []int
+		deleteKey             int
+		expectedIteratedNodes []testNode
+		expectedErr           error
+	}{
+		"empty tree": {
+			insertKeys:  []int{},
+			deleteKey:   1,
+			expectedErr: ErrEmpty,
+		},
+		"tree without deleteKey": {
+			insertKeys:  []int{10, 8, 12},
+			deleteKey:   1,
+			expectedErr: ErrKeyNotFound,
+		},
+		"single node tree": {
+			insertKeys:            []int{10},
+			deleteKey:             10,
+			expectedIteratedNodes: []testNode{},
+		},
+		"delete root with two children": {
+			insertKeys: []int{10, 8, 12},
+			deleteKey:  10,
+			expectedIteratedNodes: []testNode{
+				{12, "val12"},
+				{8, "val8"},
+			},
+		},
+		"delete causing rotation": {
+			insertKeys: []int{20, 10, 30, 40},
+			deleteKey:  10,
+			expectedIteratedNodes: []testNode{
+				{30, "val30"},
+				{20, "val20"},
+				{40, "val40"},
+			},
+		},
+		"delete causing double rotation": {
+			insertKeys: []int{20, 10, 30, 25},
+			deleteKey:  10,
+			expectedIteratedNodes: []testNode{
+				{25, "val25"},
+				{20, "val20"},
+				{30, "val30"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := NewAvl[int, string]()
+			for _, key := range test.insertKeys {
+				tree.Insert(key, valFor(key))
+			}
+			err := tree.Delete(test.deleteKey)
+			if test.expectedErr != nil {
+				a.Equal(test.expectedErr, err)
+				return
+			}
+			a.NoError(err)
+			a.Equal(test.expectedIteratedNodes, iterate(tree))
+
+			if tree.IsEmpty() {
+				return
+			}
+			valid, err := tree.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestDeleteAll(t *testing.T) {
+	a := assert.New(t)
+	n := 200
+	tree := NewAvl[int, string]()
+	for i := 0; i < n; i++ {
+		tree.Insert(i, valFor(i))
+	}
+	for i := 0; i < n; i += 2 {
+		a.NoError(tree.Delete(i))
+		valid, err := tree.Validate()
+		a.NoError(err)
+		a.True(valid)
+	}
+	for i := 0; i < n; i++ {
+		_, found := tree.Search(i)
+		a.Equal(i%2 == 1, found)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	leaf := func(key int) *Node[int, string] {
+		return &Node[int, string]{Key: key, height: 1}
+	}
+
+	tests := map[string]struct {
+		tree          *Node[int, string]
+		expectedValid bool
+		expectedErr   error
+	}{
+		"empty tree": {
+			tree:          nil,
+			expectedValid: false,
+			expectedErr:   ErrEmpty,
+		},
+		"valid tree": {
+			tree:          &Node[int, string]{Key: 10, Left: leaf(8), Right: leaf(12), height: 2},
+			expectedValid: true,
+		},
+		"tree violating key ordering": {
+			tree:          &Node[int, string]{Key: 10, Left: leaf(11), Right: leaf(12), height: 2},
+			expectedValid: false,
+		},
+		"tree violating height invariant": {
+			tree: &Node[int, string]{
+				Key: 10,
+				Left: &Node[int, string]{
+					Key:    8,
+					Left:   leaf(6),
+					height: 2,
+				},
+				height: 3,
+			},
+			expectedValid: false,
+		},
+		"tree with incorrect stored height": {
+			tree:          &Node[int, string]{Key: 10, Left: leaf(8), height: 3},
+			expectedValid: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := NewAvl[int, string]()
+			tree.Tree = test.tree
+			valid, err := tree.Validate()
+			a.Equal(test.expectedValid, valid)
+			a.Equal(test.expectedErr, err)
+		})
+	}
+}
+
+func valFor(key int) string {
+	return fmt.Sprintf("val%d", key)
+}
@@ -0,0 +1,461 @@
+package bplustree
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/dkaslovsky/search-structures/queue"
+)
+
+// Errors returned from a BPlusTree
+var (
+	ErrEmpty         error = errors.New("BPlusTree is empty")
+	ErrKeyNotFound   error = errors.New("key not found in BPlusTree")
+	ErrInvalidDegree error = errors.New("minimum degree of BPlusTree must be at least 2")
+	ErrIteratorStop  error = errors.New("iterator stopped after iterating all items")
+)
+
+// BPlusTree is an in-memory B+ tree in which all key/value pairs are stored in leaves that are
+// chained in key order, internal nodes hold only separator keys, and every node other than the root
+// holds between t-1 and 2t-1 keys for a configurable minimum degree t
+type BPlusTree[K any, V any] struct {
+	Tree      *Node[K, V]
+	minDegree int
+	cmp       func(a, b K) int
+}
+
+// NewBPlusTree constructs an empty BPlusTree with the given minimum degree ordered by the natural
+// ordering of its keys
+func NewBPlusTree[K cmp.Ordered, V any](minDegree int) (*BPlusTree[K, V], error) {
+	return NewBPlusTreeFunc[K, V](minDegree, cmp.Compare[K])
+}
+
+// NewBPlusTreeFunc constructs an empty BPlusTree with the given minimum degree ordered by a
+// comparison function that returns a negative number when a < b, a positive number when a > b, and
+// zero when a == b
+func NewBPlusTreeFunc[K any, V any](minDegree int, cmp func(a, b K) int) (*BPlusTree[K, V], error) {
+	if minDegree < 2 {
+		return nil, ErrInvalidDegree
+	}
+	return &BPlusTree[K, V]{
+		minDegree: minDegree,
+		cmp:       cmp,
+	}, nil
+}
+
+// Item is a key/value pair stored in a leaf of a BPlusTree
+type Item[K any, V any] struct {
+	Key K
+	Val V
+}
+
+// Node is a node of a BPlusTree; a leaf holds sorted Items and links to its neighboring leaves, while
+// an internal node holds sorted separator Keys and one more child than keys, where the subtree of
+// Children[i] holds the keys k with Keys[i-1] <= k < Keys[i]
+type Node[K any, V any] struct {
+	Keys     []K
+	Children []*Node[K, V]
+	Items    []Item[K, V]
+	prev     *Node[K, V]
+	next     *Node[K, V]
+}
+
+// IsLeaf evaluates if a node is a leaf
+func (n *Node[K, V]) IsLeaf() bool {
+	return len(n.Children) == 0
+}
+
+// MinDegree returns the minimum degree of a BPlusTree
+func (b *BPlusTree[K, V]) MinDegree() int {
+	return b.minDegree
+}
+
+// IsEmpty evaluates if a BPlusTree is empty
+func (b *BPlusTree[K, V]) IsEmpty() bool {
+	return b.Tree == nil
+}
+
+// Insert inserts a key/value pair
+func (b *BPlusTree[K, V]) Insert(key K, val V) {
+	if b.IsEmpty() {
+		b.Tree = &Node[K, V]{
+			Items: []Item[K, V]{{Key: key, Val: val}},
+		}
+		return
+	}
+
+	// grow the tree at the top when the root splits
+	sep, right, split := b.insert(b.Tree, key, val)
+	if split {
+		b.Tree = &Node[K, V]{
+			Keys:     []K{sep},
+			Children: []*Node[K, V]{b.Tree, right},
+		}
+	}
+}
+
+// Delete deletes a key/value pair
+func (b *BPlusTree[K, V]) Delete(key K) error {
+	if b.IsEmpty() {
+		return ErrEmpty
+	}
+
+	found := b.delete(b.Tree, key)
+
+	// shrink the tree when the root has been emptied
+	if b.Tree.IsLeaf() && len(b.Tree.Items) == 0 {
+		b.Tree = nil
+	} else if !b.Tree.IsLeaf() && len(b.Tree.Keys) == 0 {
+		b.Tree = b.Tree.Children[0]
+	}
+
+	if !found {
+		return ErrKeyNotFound
+	}
+	return nil
+}
+
+// Search searches a BPlusTree for a key
+func (b *BPlusTree[K, V]) Search(key K) (val V, found bool) {
+	if b.IsEmpty() {
+		return val, false
+	}
+
+	leaf := b.findLeaf(key)
+	i, found := b.findItem(leaf, key)
+	if !found {
+		return val, false
+	}
+	return leaf.Items[i].Val, true
+}
+
+// Range creates a function to iterate, in ascending key order, the items with keys between lo and
+// hi inclusive by returning the next item on each call
+func (b *BPlusTree[K, V]) Range(lo K, hi K) func() (Item[K, V], error) {
+	c := b.Seek(lo)
+	return func() (item Item[K, V], err error) {
+		if !c.Valid() || b.cmp(c.Key(), hi) > 0 {
+			return item, ErrIteratorStop
+		}
+		item = c.leaf.Items[c.idx]
+		c.Next()
+		return item, nil
+	}
+}
+
+// Validate determines if a BPlusTree satisfies the key ordering, node fill factor, uniform leaf
+// depth, and leaf chaining properties, returning an error describing the first violation found
+func (b *BPlusTree[K, V]) Validate() (bool, error) {
+	if b.IsEmpty() {
+		return false, ErrEmpty
+	}
+
+	leaves := []*Node[K, V]{}
+	if _, err := b.validate(b.Tree, nil, nil, true, &leaves); err != nil {
+		return false, err
+	}
+
+	// the leaf chain must visit the leaves in the same order as the tree, in both directions
+	for i, leaf := range leaves {
+		var prev, next *Node[K, V]
+		if i > 0 {
+			prev = leaves[i-1]
+		}
+		if i < len(leaves)-1 {
+			next = leaves[i+1]
+		}
+		if leaf.prev != prev || leaf.next != next {
+			return false, fmt.Errorf("leaf with first key %v is not linked to its neighboring leaves", leaf.Items[0].Key)
+		}
+	}
+	return true, nil
+}
+
+// Iterator creates a function to iterate the nodes of the BPlusTree by returning the next (breadth-first) node on each call
+func (b *BPlusTree[K, V]) Iterator() func() (*Node[K, V], error) {
+	if b.IsEmpty() {
+		return func() (*Node[K, V], error) {
+			return nil, ErrIteratorStop
+		}
+	}
+
+	q := queue.NewQueue[*Node[K, V]]()
+	q.Push(b.Tree)
+	return func() (*Node[K, V], error) {
+		curNode, err := q.Pop()
+		if err == queue.ErrEmptyQueue {
+			return nil, ErrIteratorStop
+		}
+		for _, child := range curNode.Children {
+			q.Push(child)
+		}
+		return curNode, nil
+	}
+}
+
+// findLeaf descends from the root to the leaf whose key range covers key
+func (b *BPlusTree[K, V]) findLeaf(key K) *Node[K, V] {
+	curNode := b.Tree
+	for !curNode.IsLeaf() {
+		curNode = curNode.Children[b.findChild(curNode, key)]
+	}
+	return curNode
+}
+
+// findChild returns the index of the child of internal node n whose subtree covers key
+func (b *BPlusTree[K, V]) findChild(n *Node[K, V], key K) int {
+	i, found := slices.BinarySearchFunc(n.Keys, key, b.cmp)
+	if found {
+		// keys equal to a separator are stored to its right
+		i++
+	}
+	return i
+}
+
+// findItem returns the index of the first item of leaf n with key greater than or equal to key and
+// whether that item's key is equal to key
+func (b *BPlusTree[K, V]) findItem(n *Node[K, V], key K) (int, bool) {
+	return slices.BinarySearchFunc(n.Items, key, func(item Item[K, V], key K) int {
+		return b.cmp(item.Key, key)
+	})
+}
+
+func (b *BPlusTree[K, V]) maxKeys() int {
+	return 2*b.minDegree - 1
+}
+
+func (b *BPlusTree[K, V]) minKeys() int {
+	return b.minDegree - 1
+}
+
+// insert inserts a key/value pair into the subtree rooted at n and, if n overflows and is split,
+// returns the separator key and the new right sibling of n
+func (b *BPlusTree[K, V]) insert(n *Node[K, V], key K, val V) (sep K, right *Node[K, V], split bool) {
+	if n.IsLeaf() {
+		i, found := b.findItem(n, key)
+		if found {
+			// allow an existing value to be overwritten
+			n.Items[i].Val = val
+			return sep, nil, false
+		}
+		n.Items = slices.Insert(n.Items, i, Item[K, V]{Key: key, Val: val})
+		if len(n.Items) <= b.maxKeys() {
+			return sep, nil, false
+		}
+		right = b.splitLeaf(n)
+		return right.Items[0].Key, right, true
+	}
+
+	i := b.findChild(n, key)
+	childSep, childRight, childSplit := b.insert(n.Children[i], key, val)
+	if !childSplit {
+		return sep, nil, false
+	}
+	n.Keys = slices.Insert(n.Keys, i, childSep)
+	n.Children = slices.Insert(n.Children, i+1, childRight)
+	if len(n.Keys) <= b.maxKeys() {
+		return sep, nil, false
+	}
+	sep, right = b.splitInternal(n)
+	return sep, right, true
+}
+
+// splitLeaf moves the upper half of the items of an overfull leaf into a new leaf chained after it
+func (b *BPlusTree[K, V]) splitLeaf(n *Node[K, V]) *Node[K, V] {
+	t := b.minDegree
+	right := &Node[K, V]{
+		Items: slices.Clone(n.Items[t:]),
+		prev:  n,
+		next:  n.next,
+	}
+	n.Items = slices.Clip(n.Items[:t])
+	if n.next != nil {
+		n.next.prev = right
+	}
+	n.next = right
+	return right
+}
+
+// splitInternal moves the upper half of the keys and children of an overfull internal node into a
+// new node and returns the median key, which separates the two nodes in their parent
+func (b *BPlusTree[K, V]) splitInternal(n *Node[K, V]) (K, *Node[K, V]) {
+	t := b.minDegree
+	sep := n.Keys[t]
+	right := &Node[K, V]{
+		Keys:     slices.Clone(n.Keys[t+1:]),
+		Children: slices.Clone(n.Children[t+1:]),
+	}
+	n.Keys = slices.Clip(n.Keys[:t])
+	n.Children = slices.Clip(n.Children[:t+1])
+	return sep, right
+}
+
+// delete deletes a key from the subtree rooted at n, repairing any child left underfull, and
+// returns success bool
+func (b *BPlusTree[K, V]) delete(n *Node[K, V], key K) bool {
+	if n.IsLeaf() {
+		i, found := b.findItem(n, key)
+		if !found {
+			return false
+		}
+		n.Items = slices.Delete(n.Items, i, i+1)
+		return true
+	}
+
+	i := b.findChild(n, key)
+	found := b.delete(n.Children[i], key)
+	child := n.Children[i]
+	if (child.IsLeaf() && len(child.Items) < b.minKeys()) || (!child.IsLeaf() && len(child.Keys) < b.minKeys()) {
+		b.fillChild(n, i)
+	}
+	return found
+}
+
+// fillChild repairs the underfull child at index i of n by borrowing from a sibling or merging with one
+func (b *BPlusTree[K, V]) fillChild(n *Node[K, V], i int) {
+	child := n.Children[i]
+
+	if i > 0 {
+		left := n.Children[i-1]
+		if left.IsLeaf() && len(left.Items) > b.minKeys() {
+			child.Items = slices.Insert(child.Items, 0, left.Items[len(left.Items)-1])
+			left.Items = left.Items[:len(left.Items)-1]
+			n.Keys[i-1] = child.Items[0].Key
+			return
+		}
+		if !left.IsLeaf() && len(left.Keys) > b.minKeys() {
+			// rotate through the separating key
+			child.Keys = slices.Insert(child.Keys, 0, n.Keys[i-1])
+			child.Children = slices.Insert(child.Children, 0, left.Children[len(left.Children)-1])
+			n.Keys[i-1] = left.Keys[len(left.Keys)-1]
+			left.Keys = left.Keys[:len(left.Keys)-1]
+			left.Children = left.Children[:len(left.Children)-1]
+			return
+		}
+	}
+
+	if i < len(n.Children)-1 {
+		right := n.Children[i+1]
+		if right.IsLeaf() && len(right.Items) > b.minKeys() {
+			child.Items = append(child.Items, right.Items[0])
+			right.Items = slices.Delete(right.Items, 0, 1)
+			n.Keys[i] = right.Items[0].Key
+			return
+		}
+		if !right.IsLeaf() && len(right.Keys) > b.minKeys() {
+			// rotate through the separating key
+			child.Keys = append(child.Keys, n.Keys[i])
+			child.Children = append(child.Children, right.Children[0])
+			n.Keys[i] = right.Keys[0]
+			right.Keys = slices.Delete(right.Keys, 0, 1)
+			right.Children = slices.Delete(right.Children, 0, 1)
+			return
+		}
+	}
+
+	if i < len(n.Children)-1 {
+		b.mergeChildren(n, i)
+		return
+	}
+	b.mergeChildren(n, i-1)
+}
+
+// mergeChildren merges the child at index i+1 of n into the child at index i
+func (b *BPlusTree[K, V]) mergeChildren(n *Node[K, V], i int) {
+	child, right := n.Children[i], n.Children[i+1]
+	if child.IsLeaf() {
+		child.Items = append(child.Items, right.Items...)
+		child.next = right.next
+		if right.next != nil {
+			right.next.prev = child
+		}
+	} else {
+		// the separating key is pulled down between the merged keys
+		child.Keys = append(child.Keys, n.Keys[i])
+		child.Keys = append(child.Keys, right.Keys...)
+		child.Children = append(child.Children, right.Children...)
+	}
+
+	n.Keys = slices.Delete(n.Keys, i, i+1)
+	n.Children = slices.Delete(n.Children, i+1, i+2)
+}
+
+// validate checks the subtree rooted at n against key bounds, where a nil bound indicates that the
+// subtree is unbounded on that side, appends its leaves in order, and returns the depth of its leaves
+func (b *BPlusTree[K, V]) validate(n *Node[K, V], minKey *K, maxKey *K, isRoot bool, leaves *[]*Node[K, V]) (int, error) {
+	if n.IsLeaf() {
+		if len(n.Keys) != 0 {
+			return 0, errors.New("leaf holds separator keys")
+		}
+		if err := b.validateCount(len(n.Items), isRoot); err != nil {
+			return 0, err
+		}
+		for i, item := range n.Items {
+			// keys in a subtree are greater than or equal to the separator on their left
+			if minKey != nil && b.cmp(item.Key, *minKey) < 0 {
+				return 0, fmt.Errorf("item with key %v is out of order", item.Key)
+			}
+			if maxKey != nil && b.cmp(item.Key, *maxKey) >= 0 {
+				return 0, fmt.Errorf("item with key %v is out of order", item.Key)
+			}
+			if i > 0 && b.cmp(item.Key, n.Items[i-1].Key) <= 0 {
+				return 0, fmt.Errorf("item with key %v is out of order", item.Key)
+			}
+		}
+		*leaves = append(*leaves, n)
+		return 1, nil
+	}
+
+	if len(n.Items) != 0 {
+		return 0, errors.New("internal node holds items")
+	}
+	if err := b.validateCount(len(n.Keys), isRoot); err != nil {
+		return 0, err
+	}
+	if len(n.Children) != len(n.Keys)+1 {
+		return 0, fmt.Errorf("node holds %d keys and %d children", len(n.Keys), len(n.Children))
+	}
+	for i, key := range n.Keys {
+		if (minKey != nil && b.cmp(key, *minKey) < 0) || (maxKey != nil && b.cmp(key, *maxKey) >= 0) {
+			return 0, fmt.Errorf("separator key %v is out of order", key)
+		}
+		if i > 0 && b.cmp(key, n.Keys[i-1]) <= 0 {
+			return 0, fmt.Errorf("separator key %v is out of order", key)
+		}
+	}
+
+	depth := 0
+	for i, child := range n.Children {
+		lo, hi := minKey, maxKey
+		if i > 0 {
+			lo = &n.Keys[i-1]
+		}
+		if i < len(n.Keys) {
+			hi = &n.Keys[i]
+		}
+		childDepth, err := b.validate(child, lo, hi, false, leaves)
+		if err != nil {
+			return 0, err
+		}
+		if i > 0 && childDepth != depth {
+			return 0, fmt.Errorf("leaves below separator key %v are not all at the same depth", n.Keys[i-1])
+		}
+		depth = childDepth
+	}
+	return depth + 1, nil
+}
+
+func (b *BPlusTree[K, V]) validateCount(count int, isRoot bool) error {
+	if count > b.maxKeys() {
+		return fmt.Errorf("node holds %d keys, more than the maximum of %d", count, b.maxKeys())
+	}
+	if !isRoot && count < b.minKeys() {
+		return fmt.Errorf("node holds %d keys, fewer than the minimum of %d", count, b.minKeys())
+	}
+	if count == 0 {
+		return errors.New("node holds no keys")
+	}
+	return nil
+}
@@ -0,0 +1,444 @@
+package bplustree
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTree(t *testing.T, minDegree int, keys ...int) *BPlusTree[int, string] {
+	tree, err := NewBPlusTree[int, string](minDegree)
+	assert.NoError(t, err)
+	for _, key := range keys {
+		tree.Insert(key, valFor(key))
+	}
+	return tree
+}
+
+func collect(next func() (Item[int, string], error)) []int {
+	keys := []int{}
+	for {
+		item, err := next()
+		if err == ErrIteratorStop {
+			return keys
+		}
+		keys = append(keys, item.Key)
+	}
+}
+
+func TestNewBPlusTree(t *testing.T) {
+	tests := map[string]struct {
+		minDegree   int
+		expectedErr error
+	}{
+		"minimum degree too small": {
+			minDegree:   1,
+			expectedErr: ErrInvalidDegree,
+		},
+		"minimum degree of two": {
+			minDegree:   2,
+			expectedErr: nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree, err := NewBPlusTree[int, string](test.minDegree)
+			a.Equal(test.expectedErr, err)
+			if err != nil {
+				return
+			}
+			a.Equal(test.minDegree, tree.MinDegree())
+			a.True(tree.IsEmpty())
+		})
+	}
+}
+
+func TestInsert(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys     []int
+		expectedLevels [][][]int
+	}{
+		"single insert": {
+			insertKeys:     []int{10},
+			expectedLevels: [][][]int{{{10}}},
+		},
+		"insert fills root leaf": {
+			insertKeys:     []int{30, 10, 20},
+			expectedLevels: [][][]int{{{10, 20, 30}}},
+		},
+		"insert splits root leaf and copies separator up": {
+			insertKeys: []int{30, 10, 20, 40},
+			expectedLevels: [][][]int{
+				{{30}},
+				{{10, 20}, {30, 40}},
+			},
+		},
+		"insert splits internal node and moves separator up": {
+			insertKeys: []int{10, 20, 30, 40, 50, 60, 70, 80, 90, 100},
+			expectedLevels: [][][]int{
+				{{70}},
+				{{30, 50}, {90}},
+				{{10, 20}, {30, 40}, {50, 60}, {70, 80}, {90, 100}},
+			},
+		},
+		"duplicate insert does not grow tree": {
+			insertKeys:     []int{10, 20, 10, 20},
+			expectedLevels: [][][]int{{{10, 20}}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := newTestTree(t, 2, test.insertKeys...)
+			a.Equal(test.expectedLevels, levels(tree))
+
+			valid, err := tree.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestInsertOverwrite(t *testing.T) {
+	a := assert.New(t)
+	tree := newTestTree(t, 2, 10, 20, 30, 40)
+	tree.Insert(30, "newVal30")
+
+	val, found := tree.Search(30)
+	a.True(found)
+	a.Equal("newVal30", val)
+}
+
+func TestSearch(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys     []int
+		searchKey      int
+		expectedExists bool
+	}{
+		"empty tree": {
+			insertKeys:     []int{},
+			searchKey:      1,
+			expectedExists: false,
+		},
+		"tree without searchKey": {
+			insertKeys:     []int{10, 20, 30, 40, 50, 60},
+			searchKey:      35,
+			expectedExists: false,
+		},
+		"tree with searchKey equal to separator": {
+			insertKeys:     []int{10, 20, 30, 40, 50, 60},
+			searchKey:      30,
+			expectedExists: true,
+		},
+		"tree with searchKey": {
+			insertKeys:     []int{10, 20, 30, 40, 50, 60},
+			searchKey:      60,
+			expectedExists: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := newTestTree(t, 2, test.insertKeys...)
+			val, exists := tree.Search(test.searchKey)
+			a.Equal(test.expectedExists, exists)
+			if !test.expectedExists {
+				return
+			}
+			a.Equal(valFor(test.searchKey), val)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys     []int
+		deleteKeys     []int
+		expectedLevels [][][]int
+		expectedErr    error
+	}{
+		"empty tree": {
+			insertKeys:  []int{},
+			deleteKeys:  []int{1},
+			expectedErr: ErrEmpty,
+		},
+		"tree without deleteKey": {
+			insertKeys:  []int{10, 20, 30},
+			deleteKeys:  []int{1},
+			expectedErr: ErrKeyNotFound,
+		},
+		"single item tree": {
+			insertKeys:     []int{10},
+			deleteKeys:     []int{10},
+			expectedLevels: [][][]int{},
+		},
+		"delete leaving separator in place": {
+			insertKeys: []int{10, 20, 30, 40, 50},
+			deleteKeys: []int{30},
+			expectedLevels: [][][]int{
+				{{30}},
+				{{10, 20}, {40, 50}},
+			},
+		},
+		"delete borrowing from left leaf": {
+			insertKeys: []int{10, 20, 30, 40, 15},
+			deleteKeys: []int{30, 40},
+			expectedLevels: [][][]int{
+				{{20}},
+				{{10, 15}, {20}},
+			},
+		},
+		"delete borrowing from right leaf": {
+			insertKeys: []int{10, 20, 30, 40, 50},
+			deleteKeys: []int{20, 10},
+			expectedLevels: [][][]int{
+				{{40}},
+				{{30}, {40, 50}},
+			},
+		},
+		"delete merging leaves shrinks tree": {
+			insertKeys:     []int{10, 20, 30, 40},
+			deleteKeys:     []int{20, 30, 40},
+			expectedLevels: [][][]int{{{10}}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := newTestTree(t, 2, test.insertKeys...)
+			var err error
+			for _, key := range test.deleteKeys {
+				err = tree.Delete(key)
+			}
+			if test.expectedErr != nil {
+				a.Equal(test.expectedErr, err)
+				return
+			}
+			a.NoError(err)
+			a.Equal(test.expectedLevels, levels(tree))
+
+			if tree.IsEmpty() {
+				return
+			}
+			valid, err := tree.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestRandomInsertDelete(t *testing.T) {
+	for _, minDegree := range []int{2, 3, 5, 16} {
+		t.Run(fmt.Sprintf("minimum degree %d", minDegree), func(t *testing.T) {
+			a := assert.New(t)
+			r := rand.New(rand.NewSource(1))
+			n := 1000
+			tree := newTestTree(t, minDegree, r.Perm(n)...)
+
+			valid, err := tree.Validate()
+			a.NoError(err)
+			a.True(valid)
+
+			deleted := map[int]bool{}
+			for _, key := range r.Perm(n)[:n/2] {
+				a.NoError(tree.Delete(key))
+				deleted[key] = true
+				valid, err := tree.Validate()
+				a.NoError(err)
+				a.True(valid)
+			}
+			for key := 0; key < n; key++ {
+				_, found := tree.Search(key)
+				a.Equal(!deleted[key], found)
+			}
+		})
+	}
+}
+
+func TestDeleteAll(t *testing.T) {
+	a := assert.New(t)
+	n := 100
+	tree := newTestTree(t, 2)
+	for key := 0; key < n; key++ {
+		tree.Insert(key, valFor(key))
+	}
+	for key := n - 1; key >= 0; key-- {
+		a.NoError(tree.Delete(key))
+		if tree.IsEmpty() {
+			break
+		}
+		valid, err := tree.Validate()
+		a.NoError(err)
+		a.True(valid)
+	}
+	a.True(tree.IsEmpty())
+}
+
+func TestRange(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys   []int
+		lo           int
+		hi           int
+		expectedKeys []int
+	}{
+		"empty tree": {
+			insertKeys:   []int{},
+			lo:           1,
+			hi:           10,
+			expectedKeys: []int{},
+		},
+		"full range": {
+			insertKeys:   []int{50, 10, 40, 20, 30, 60, 70},
+			lo:           0,
+			hi:           100,
+			expectedKeys: []int{10, 20, 30, 40, 50, 60, 70},
+		},
+		"bounds on existing keys are inclusive": {
+			insertKeys:   []int{50, 10, 40, 20, 30, 60, 70},
+			lo:           20,
+			hi:           60,
+			expectedKeys: []int{20, 30, 40, 50, 60},
+		},
+		"bounds between keys spanning leaves": {
+			insertKeys:   []int{50, 10, 40, 20, 30, 60, 70},
+			lo:           15,
+			hi:           45,
+			expectedKeys: []int{20, 30, 40},
+		},
+		"empty range": {
+			insertKeys:   []int{50, 10, 40, 20, 30, 60, 70},
+			lo:           41,
+			hi:           49,
+			expectedKeys: []int{},
+		},
+		"lo above all keys": {
+			insertKeys:   []int{50, 10, 40, 20, 30, 60, 70},
+			lo:           71,
+			hi:           100,
+			expectedKeys: []int{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			tree := newTestTree(t, 2, test.insertKeys...)
+			assert.Equal(t, test.expectedKeys, collect(tree.Range(test.lo, test.hi)))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	leaf := func(keys ...int) *Node[int, string] {
+		n := &Node[int, string]{}
+		for _, key := range keys {
+			n.Items = append(n.Items, Item[int, string]{Key: key})
+		}
+		return n
+	}
+	internal := func(keys []int, children ...*Node[int, string]) *Node[int, string] {
+		for i := 1; i < len(children); i++ {
+			if children[i].IsLeaf() {
+				children[i-1].next = children[i]
+				children[i].prev = children[i-1]
+			}
+		}
+		return &Node[int, string]{Keys: keys, Children: children}
+	}
+
+	tests := map[string]struct {
+		tree          *Node[int, string]
+		expectedValid bool
+	}{
+		"valid tree": {
+			tree:          internal([]int{30}, leaf(10, 20), leaf(30, 40)),
+			expectedValid: true,
+		},
+		"overfull leaf": {
+			tree:          leaf(10, 20, 30, 40),
+			expectedValid: false,
+		},
+		"underfull leaf": {
+			tree:          internal([]int{30}, leaf(), leaf(30, 40)),
+			expectedValid: false,
+		},
+		"leaf keys out of order": {
+			tree:          leaf(10, 30, 20),
+			expectedValid: false,
+		},
+		"leaf key below separator": {
+			tree:          internal([]int{30}, leaf(10, 20), leaf(25, 40)),
+			expectedValid: false,
+		},
+		"leaf key equal to separator on the left": {
+			tree:          internal([]int{30}, leaf(10, 30), leaf(35, 40)),
+			expectedValid: false,
+		},
+		"leaves not chained": {
+			tree: &Node[int, string]{
+				Keys:     []int{30},
+				Children: []*Node[int, string]{leaf(10, 20), leaf(30, 40)},
+			},
+			expectedValid: false,
+		},
+		"leaves at different depths": {
+			tree: internal([]int{30},
+				leaf(10, 20),
+				internal([]int{40}, leaf(30), leaf(40, 50)),
+			),
+			expectedValid: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := newTestTree(t, 2)
+			tree.Tree = test.tree
+			valid, err := tree.Validate()
+			a.Equal(test.expectedValid, valid)
+			a.Equal(!test.expectedValid, err != nil)
+		})
+	}
+
+	t.Run("empty tree", func(t *testing.T) {
+		a := assert.New(t)
+		valid, err := newTestTree(t, 2).Validate()
+		a.False(valid)
+		a.Equal(ErrEmpty, err)
+	})
+}
+
+// levels returns the keys of each node of a BPlusTree grouped by depth
+func levels(tree *BPlusTree[int, string]) [][][]int {
+	result := [][][]int{}
+	level := []*Node[int, string]{}
+	if !tree.IsEmpty() {
+		level = append(level, tree.Tree)
+	}
+	for len(level) > 0 {
+		keys := [][]int{}
+		next := []*Node[int, string]{}
+		for _, n := range level {
+			nodeKeys := append([]int{}, n.Keys...)
+			for _, item := range n.Items {
+				nodeKeys = append(nodeKeys, item.Key)
+			}
+			keys = append(keys, nodeKeys)
+			next = append(next, n.Children...)
+		}
+		result = append(result, keys)
+		level = next
+	}
+	return result
+}
+
+func valFor(key int) string {
+	return fmt.Sprintf("val%d", key)
+}
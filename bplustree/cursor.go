@@ -0,0 +1,92 @@
+package bplustree
+
+// Cursor is a position within the chained leaves of a BPlusTree that can be moved forward and
+// backward in key order; a Cursor is invalidated by any modification of the tree
+type Cursor[K any, V any] struct {
+	leaf *Node[K, V]
+	idx  int
+}
+
+// First returns a Cursor positioned at the item with the smallest key, for iterating forward
+func (b *BPlusTree[K, V]) First() *Cursor[K, V] {
+	if b.IsEmpty() {
+		return &Cursor[K, V]{}
+	}
+
+	curNode := b.Tree
+	for !curNode.IsLeaf() {
+		curNode = curNode.Children[0]
+	}
+	return &Cursor[K, V]{leaf: curNode, idx: 0}
+}
+
+// Last returns a Cursor positioned at the item with the largest key, for iterating in reverse
+func (b *BPlusTree[K, V]) Last() *Cursor[K, V] {
+	if b.IsEmpty() {
+		return &Cursor[K, V]{}
+	}
+
+	curNode := b.Tree
+	for !curNode.IsLeaf() {
+		curNode = curNode.Children[len(curNode.Children)-1]
+	}
+	return &Cursor[K, V]{leaf: curNode, idx: len(curNode.Items) - 1}
+}
+
+// Seek returns a Cursor positioned at the item with the smallest key greater than or equal to key
+func (b *BPlusTree[K, V]) Seek(key K) *Cursor[K, V] {
+	if b.IsEmpty() {
+		return &Cursor[K, V]{}
+	}
+
+	leaf := b.findLeaf(key)
+	i, _ := b.findItem(leaf, key)
+	c := &Cursor[K, V]{leaf: leaf, idx: i}
+	if i == len(leaf.Items) {
+		// every key of the leaf is less than key, so the next item is at the start of the next leaf
+		c.leaf, c.idx = leaf.next, 0
+	}
+	return c
+}
+
+// Valid evaluates if a Cursor is positioned at an item
+func (c *Cursor[K, V]) Valid() bool {
+	return c.leaf != nil
+}
+
+// Key returns the key of the item at a Cursor's position, which must be valid
+func (c *Cursor[K, V]) Key() K {
+	return c.leaf.Items[c.idx].Key
+}
+
+// Val returns the value of the item at a Cursor's position, which must be valid
+func (c *Cursor[K, V]) Val() V {
+	return c.leaf.Items[c.idx].Val
+}
+
+// Next moves a Cursor to the item with the next larger key, invalidating it after the last item
+func (c *Cursor[K, V]) Next() {
+	if !c.Valid() {
+		return
+	}
+	c.idx++
+	if c.idx < len(c.leaf.Items) {
+		return
+	}
+	c.leaf, c.idx = c.leaf.next, 0
+}
+
+// Prev moves a Cursor to the item with the next smaller key, invalidating it before the first item
+func (c *Cursor[K, V]) Prev() {
+	if !c.Valid() {
+		return
+	}
+	c.idx--
+	if c.idx >= 0 {
+		return
+	}
+	c.leaf = c.leaf.prev
+	if c.leaf != nil {
+		c.idx = len(c.leaf.Items) - 1
+	}
+}
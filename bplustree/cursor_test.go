@@ -0,0 +1,135 @@
+package bplustree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursorForward(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys   []int
+		expectedKeys []int
+	}{
+		"empty tree": {
+			insertKeys:   []int{},
+			expectedKeys: []int{},
+		},
+		"single leaf": {
+			insertKeys:   []int{20, 10, 30},
+			expectedKeys: []int{10, 20, 30},
+		},
+		"multiple leaves": {
+			insertKeys:   []int{50, 10, 40, 20, 30, 60, 70, 90, 80},
+			expectedKeys: []int{10, 20, 30, 40, 50, 60, 70, 80, 90},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := newTestTree(t, 2, test.insertKeys...)
+			keys := []int{}
+			for c := tree.First(); c.Valid(); c.Next() {
+				a.Equal(valFor(c.Key()), c.Val())
+				keys = append(keys, c.Key())
+			}
+			a.Equal(test.expectedKeys, keys)
+		})
+	}
+}
+
+func TestCursorReverse(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys   []int
+		expectedKeys []int
+	}{
+		"empty tree": {
+			insertKeys:   []int{},
+			expectedKeys: []int{},
+		},
+		"single leaf": {
+			insertKeys:   []int{20, 10, 30},
+			expectedKeys: []int{30, 20, 10},
+		},
+		"multiple leaves": {
+			insertKeys:   []int{50, 10, 40, 20, 30, 60, 70, 90, 80},
+			expectedKeys: []int{90, 80, 70, 60, 50, 40, 30, 20, 10},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := newTestTree(t, 2, test.insertKeys...)
+			keys := []int{}
+			for c := tree.Last(); c.Valid(); c.Prev() {
+				a.Equal(valFor(c.Key()), c.Val())
+				keys = append(keys, c.Key())
+			}
+			a.Equal(test.expectedKeys, keys)
+		})
+	}
+}
+
+func TestCursorSeek(t *testing.T) {
+	tests := map[string]struct {
+		seekKey       int
+		expectedValid bool
+		expectedKey   int
+	}{
+		"seek existing key": {
+			seekKey:       40,
+			expectedValid: true,
+			expectedKey:   40,
+		},
+		"seek between keys": {
+			seekKey:       35,
+			expectedValid: true,
+			expectedKey:   40,
+		},
+		"seek past end of leaf": {
+			seekKey:       21,
+			expectedValid: true,
+			expectedKey:   30,
+		},
+		"seek below all keys": {
+			seekKey:       0,
+			expectedValid: true,
+			expectedKey:   10,
+		},
+		"seek above all keys": {
+			seekKey:       100,
+			expectedValid: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := newTestTree(t, 2, 50, 10, 40, 20, 30, 60, 70)
+			c := tree.Seek(test.seekKey)
+			a.Equal(test.expectedValid, c.Valid())
+			if !test.expectedValid {
+				return
+			}
+			a.Equal(test.expectedKey, c.Key())
+		})
+	}
+}
+
+func TestCursorChangeDirection(t *testing.T) {
+	a := assert.New(t)
+	tree := newTestTree(t, 2, 50, 10, 40, 20, 30, 60, 70)
+	c := tree.Seek(30)
+	c.Next()
+	c.Next()
+	a.Equal(50, c.Key())
+	c.Prev()
+	c.Prev()
+	c.Prev()
+	a.Equal(20, c.Key())
+	c.Prev()
+	c.Prev()
+	a.False(c.Valid())
+}
@@ -1,162 +1,456 @@
 package bst
 
 import (
+	"cmp"
 	"errors"
-	"fmt"
-	"math"
+	"iter"
 	"math/rand"
 	"time"
 
 	"github.com/dkaslovsky/search-structures/queue"
+	"github.com/dkaslovsky/search-structures/stack"
 )
 
 // Errors returned from a Bst
 var (
-	ErrEmpty          error = errors.New("Bst is empty")
-	ErrKeyNotFound    error = errors.New("key not found in Bst")
-	ErrDeleteRootLeaf error = errors.New("cannot delete node that is both a leaf and root of Bst")
-	ErrIteratorStop   error = errors.New("iterator stopped after iterating all nodes")
+	ErrEmpty           error = errors.New("Bst is empty")
+	ErrKeyNotFound     error = errors.New("key not found in Bst")
+	ErrOutOfRange      error = errors.New("index out of range of Bst")
+	ErrInvalidRotation error = errors.New("node lacks the child required for rotation")
+	ErrIteratorStop    error = errors.New("iterator stopped after iterating all nodes")
 )
 
 // Bst is a binary search tree
-type Bst struct {
-	Tree *Node
-	r    *rand.Rand
+type Bst[K any, V any] struct {
+	Tree     *Node[K, V]
+	cmp      func(a, b K) int
+	r        *rand.Rand
+	strategy DeleteStrategy
+	// rebalanceFactor is the multiple of log2 of the size beyond which the height of the Bst triggers
+	// a rebalance, with zero disabling automatic rebalancing
+	rebalanceFactor float64
+	// newRand constructs the source of randomness for copies of the Bst
+	newRand func() *rand.Rand
+	// lastSide is the side used by the most recent delete under DeleteAlternating
+	lastSide side
+	// gen identifies the nodes that the Bst owns and may modify in place
+	gen uint64
 }
 
-// NewBst constructs a Bst
-func NewBst(tree *Node) *Bst {
-	return &Bst{
-		Tree: tree,
-		r:    rand.New(rand.NewSource(time.Now().UnixNano())),
+// NewBst constructs a Bst ordered by the natural ordering of its keys
+func NewBst[K cmp.Ordered, V any](tree *Node[K, V], opts ...Option) *Bst[K, V] {
+	return NewBstFunc(tree, cmp.Compare[K], opts...)
+}
+
+// NewBstFunc constructs a Bst ordered by a comparison function that returns a negative number when
+// a < b, a positive number when a > b, and zero when a == b
+func NewBstFunc[K any, V any](tree *Node[K, V], cmp func(a, b K) int, opts ...Option) *Bst[K, V] {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.newRand == nil {
+		o.newRand = newTimeSeededRand
+	}
+	if o.r == nil {
+		o.r = o.newRand()
 	}
+
+	return &Bst[K, V]{
+		Tree:            tree,
+		cmp:             cmp,
+		r:               o.r,
+		newRand:         o.newRand,
+		strategy:        o.strategy,
+		rebalanceFactor: o.rebalanceFactor,
+		lastSide:        rightSide,
+	}
+}
+
+// newTimeSeededRand constructs a source of randomness seeded from the current time
+func newTimeSeededRand() *rand.Rand {
+	return rand.New(rand.NewSource(time.Now().UnixNano()))
 }
 
-// Node is a node of a binary search tree indexed by Key containing value Val
-type Node struct {
-	Key   int64
-	Val   string
-	Left  *Node
-	Right *Node
+// Node is a node of a binary search tree indexed by Key containing value Val; the size of each node's
+// subtree is maintained by a Bst, so nodes should only be linked through NewNode or the Bst's methods
+type Node[K any, V any] struct {
+	Key   K
+	Val   V
+	Left  *Node[K, V]
+	Right *Node[K, V]
+	size  int
+	// gen identifies the Bst that owns the node, which may be shared with snapshots of that Bst
+	gen uint64
 }
 
 // NewNode constructs a node
-func NewNode(key int64, val string, left *Node, right *Node) *Node {
-	return &Node{
+func NewNode[K any, V any](key K, val V, left *Node[K, V], right *Node[K, V]) *Node[K, V] {
+	return &Node[K, V]{
 		Key:   key,
 		Val:   val,
 		Left:  left,
 		Right: right,
+		size:  1 + left.Size() + right.Size(),
 	}
 }
 
+// Size returns the number of nodes in the subtree rooted at a node
+func (n *Node[K, V]) Size() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// Len returns the number of nodes in a Bst
+func (b *Bst[K, V]) Len() int {
+	return b.Tree.Size()
+}
+
 // IsEmpty evaluates is a Bst is empty
-func (b *Bst) IsEmpty() bool {
+func (b *Bst[K, V]) IsEmpty() bool {
 	return b.Tree == nil
 }
 
 // Insert inserts a key/value pair
-func (b *Bst) Insert(key int64, val string) {
+func (b *Bst[K, V]) Insert(key K, val V) {
 	if b.IsEmpty() {
-		b.Tree = NewNode(key, val, nil, nil)
+		b.Tree = b.newNode(key, val)
 		return
 	}
 
+	// record the path from the root so that sizes are only updated once the key is known to be new,
+	// copying any nodes shared with a snapshot before they are modified
+	path := []*Node[K, V]{}
+	b.Tree = b.own(b.Tree)
 	curTree := b.Tree
 	for {
-		if key == curTree.Key {
+		path = append(path, curTree)
+		c := b.cmp(key, curTree.Key)
+		if c == 0 {
 			// allow an existing value to be overwritten
 			curTree.Val = val
 			return
 		}
-		if key < curTree.Key {
+		if c < 0 {
 			if curTree.Left == nil {
-				curTree.Left = NewNode(key, val, nil, nil)
-				return
+				curTree.Left = b.newNode(key, val)
+				break
 			}
+			curTree.Left = b.own(curTree.Left)
 			curTree = curTree.Left
 			continue
 		}
 		if curTree.Right == nil {
-			curTree.Right = NewNode(key, val, nil, nil)
-			return
+			curTree.Right = b.newNode(key, val)
+			break
 		}
+		curTree.Right = b.own(curTree.Right)
 		curTree = curTree.Right
 	}
+
+	for _, n := range path {
+		n.size++
+	}
+
+	// the new node is one level below the last node on the path
+	if b.needsRebalance(len(path) + 1) {
+		b.Rebalance()
+	}
 }
 
 // Delete deletes a key/value pair
-func (b *Bst) Delete(key int64) error {
+func (b *Bst[K, V]) Delete(key K) error {
+	_, err := b.Take(key)
+	return err
+}
+
+// Take deletes a key/value pair and returns the deleted value
+func (b *Bst[K, V]) Take(key K) (val V, err error) {
 	if b.IsEmpty() {
-		return ErrEmpty
+		return val, ErrEmpty
 	}
+	return b.deleteBySide(key, b.chooseSide())
+}
 
-	// when the node-to-be-deleted has both left and right children, choose side to use for deleting
-	// at random to avoid creating an unbalanced tree
-	deleteSide := leftSide
-	if b.r.Float64() > 0.5 {
-		deleteSide = rightSide
+// DeleteMin deletes and returns the key/value pair with the smallest key
+func (b *Bst[K, V]) DeleteMin() (key K, val V, err error) {
+	if b.IsEmpty() {
+		return key, val, ErrEmpty
 	}
 
-	return b.deleteBySide(key, deleteSide)
+	// the leftmost node has no left child, so its right subtree takes its place
+	left, _ := b.Tree.findLeftMost()
+	left, parent := b.ownPath(left)
+	b.shrinkPath(left)
+	b.replaceChild(parent, left, left.Right)
+	return left.Key, left.Val, nil
+}
+
+// DeleteMax deletes and returns the key/value pair with the largest key
+func (b *Bst[K, V]) DeleteMax() (key K, val V, err error) {
+	if b.IsEmpty() {
+		return key, val, ErrEmpty
+	}
+
+	// the rightmost node has no right child, so its left subtree takes its place
+	right, _ := b.Tree.findRightMost()
+	right, parent := b.ownPath(right)
+	b.shrinkPath(right)
+	b.replaceChild(parent, right, right.Left)
+	return right.Key, right.Val, nil
+}
+
+// DeleteStrategy returns the DeleteStrategy used by a Bst
+func (b *Bst[K, V]) DeleteStrategy() DeleteStrategy {
+	return b.strategy
+}
+
+// chooseSide returns the side to use for deleting a node with both left and right children
+func (b *Bst[K, V]) chooseSide() side {
+	// when the node-to-be-deleted has both left and right children, choose side to use for deleting
+	// according to the strategy, which is at random by default to avoid creating an unbalanced tree
+	switch b.strategy {
+	case DeleteLeft:
+		return leftSide
+	case DeleteRight:
+		return rightSide
+	case DeleteAlternating:
+		if b.lastSide == leftSide {
+			b.lastSide = rightSide
+		} else {
+			b.lastSide = leftSide
+		}
+		return b.lastSide
+	default:
+		// DeleteRandom is the only remaining strategy since WithDeleteStrategy ignores unknown values
+		if b.r.Float64() > 0.5 {
+			return rightSide
+		}
+		return leftSide
+	}
 }
 
 // Search searches a Bst for a key
-func (b *Bst) Search(key int64) (val string, found bool) {
+func (b *Bst[K, V]) Search(key K) (val V, found bool) {
 	if b.IsEmpty() {
 		return val, false
 	}
 
 	target, _, found := b.search(key)
 	if !found {
-		return "", false
+		return val, false
 	}
 	return target.Val, true
 }
 
-// Validate determines if a Bst satisfies the Bst property
-func (b *Bst) Validate() (bool, error) {
+// Min returns the key/value pair with the smallest key
+func (b *Bst[K, V]) Min() (key K, val V, err error) {
+	if b.IsEmpty() {
+		return key, val, ErrEmpty
+	}
+	left, _ := b.Tree.findLeftMost()
+	return left.Key, left.Val, nil
+}
+
+// Max returns the key/value pair with the largest key
+func (b *Bst[K, V]) Max() (key K, val V, err error) {
+	if b.IsEmpty() {
+		return key, val, ErrEmpty
+	}
+	right, _ := b.Tree.findRightMost()
+	return right.Key, right.Val, nil
+}
+
+// Floor returns the key/value pair with the largest key less than or equal to key
+func (b *Bst[K, V]) Floor(key K) (K, V, error) {
+	return b.result(b.lower(key, true))
+}
+
+// Ceiling returns the key/value pair with the smallest key greater than or equal to key
+func (b *Bst[K, V]) Ceiling(key K) (K, V, error) {
+	return b.result(b.upper(key, true))
+}
+
+// Predecessor returns the key/value pair with the largest key strictly less than key, which need not
+// be in the Bst
+func (b *Bst[K, V]) Predecessor(key K) (K, V, error) {
+	return b.result(b.lower(key, false))
+}
+
+// Successor returns the key/value pair with the smallest key strictly greater than key, which need not
+// be in the Bst
+func (b *Bst[K, V]) Successor(key K) (K, V, error) {
+	return b.result(b.upper(key, false))
+}
+
+// Select returns the key/value pair with the k-th smallest key, counting from zero
+func (b *Bst[K, V]) Select(k int) (key K, val V, err error) {
+	if b.IsEmpty() {
+		return key, val, ErrEmpty
+	}
+	if k < 0 || k >= b.Len() {
+		return key, val, ErrOutOfRange
+	}
+
+	curTree := b.Tree
+	for {
+		leftSize := curTree.Left.Size()
+		if k == leftSize {
+			return curTree.Key, curTree.Val, nil
+		}
+		if k < leftSize {
+			curTree = curTree.Left
+			continue
+		}
+		k -= leftSize + 1
+		curTree = curTree.Right
+	}
+}
+
+// Rank returns the number of keys in a Bst that are less than key, which need not be in the Bst
+func (b *Bst[K, V]) Rank(key K) int {
+	rank, _ := b.rank(key)
+	return rank
+}
+
+// RangeOption configures the bounds and order of a range query
+type RangeOption func(*rangeConfig)
+
+type rangeConfig struct {
+	exclusiveLo bool
+	exclusiveHi bool
+	reverse     bool
+}
+
+// WithExclusiveLo excludes the lower bound from a range query
+func WithExclusiveLo() RangeOption {
+	return func(c *rangeConfig) {
+		c.exclusiveLo = true
+	}
+}
+
+// WithExclusiveHi excludes the upper bound from a range query
+func WithExclusiveHi() RangeOption {
+	return func(c *rangeConfig) {
+		c.exclusiveHi = true
+	}
+}
+
+// WithReverse iterates a range query in descending key order
+func WithReverse() RangeOption {
+	return func(c *rangeConfig) {
+		c.reverse = true
+	}
+}
+
+// Range creates a function to iterate the nodes with keys between lo and hi, inclusive unless
+// configured otherwise, by returning the next (ascending key order unless configured otherwise) node
+// on each call; only subtrees that overlap the range are visited
+func (b *Bst[K, V]) Range(lo K, hi K, opts ...RangeOption) func() (*Node[K, V], error) {
+	config := &rangeConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	aboveLo := func(key K) bool {
+		c := b.cmp(key, lo)
+		return c > 0 || (c == 0 && !config.exclusiveLo)
+	}
+	belowHi := func(key K) bool {
+		c := b.cmp(key, hi)
+		return c < 0 || (c == 0 && !config.exclusiveHi)
+	}
+
+	// walk from the bound at which iteration starts and finish at the other
+	afterStart, beforeEnd := aboveLo, belowHi
+	if config.reverse {
+		afterStart, beforeEnd = beforeEnd, afterStart
+	}
+
+	next := inOrder(b.Tree, config.reverse, afterStart)
+	done := false
+	return func() (*Node[K, V], error) {
+		if done {
+			return nil, ErrIteratorStop
+		}
+		curNode, err := next()
+		if err == ErrIteratorStop {
+			return nil, ErrIteratorStop
+		}
+		if !beforeEnd(curNode.Key) {
+			// every remaining node lies past the end of the range
+			done = true
+			return nil, ErrIteratorStop
+		}
+		return curNode, nil
+	}
+}
+
+// RangeCount returns the number of keys between lo and hi, inclusive unless configured otherwise,
+// without visiting the nodes in the range
+func (b *Bst[K, V]) RangeCount(lo K, hi K, opts ...RangeOption) int {
+	config := &rangeConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	start, found := b.rank(lo)
+	if found && config.exclusiveLo {
+		start++
+	}
+	end, found := b.rank(hi)
+	if found && !config.exclusiveHi {
+		end++
+	}
+	return max(end-start, 0)
+}
+
+// Validate determines if a Bst satisfies the Bst property and that the size of every subtree is correct
+func (b *Bst[K, V]) Validate() (bool, error) {
 	if b.IsEmpty() {
 		return false, ErrEmpty
 	}
 
+	// a nil bound indicates that the subtree is unbounded on that side
 	type validationNode struct {
-		*Node
-		minKey int64
-		maxKey int64
+		*Node[K, V]
+		minKey *K
+		maxKey *K
 	}
 
-	q := queue.NewQueue()
+	q := queue.NewQueue[*validationNode]()
 	q.Push(&validationNode{
-		Node:   b.Tree,
-		minKey: math.MinInt64,
-		maxKey: math.MaxInt64,
+		Node: b.Tree,
 	})
 
 	for {
-		item, err := q.Pop()
+		curNode, err := q.Pop()
 		if err == queue.ErrEmptyQueue {
 			return true, nil
 		}
-		curNode, ok := item.(*validationNode)
-		if !ok {
-			return false, errors.New("reached node of unknown type while traversing tree")
-		}
 		if curNode.Node == nil {
 			continue
 		}
-		if (curNode.Key < curNode.minKey) || (curNode.Key > curNode.maxKey) {
+		if curNode.minKey != nil && b.cmp(curNode.Key, *curNode.minKey) <= 0 {
+			return false, nil
+		}
+		if curNode.maxKey != nil && b.cmp(curNode.Key, *curNode.maxKey) >= 0 {
+			return false, nil
+		}
+		if curNode.size != 1+curNode.Left.Size()+curNode.Right.Size() {
 			return false, nil
 		}
 
 		left := &validationNode{
 			Node:   curNode.Left,
 			minKey: curNode.minKey,
-			maxKey: curNode.Key - 1,
+			maxKey: &curNode.Key,
 		}
 		right := &validationNode{
 			Node:   curNode.Right,
-			minKey: curNode.Key + 1,
+			minKey: &curNode.Key,
 			maxKey: curNode.maxKey,
 		}
 		q.Push(left)
@@ -165,24 +459,20 @@ func (b *Bst) Validate() (bool, error) {
 }
 
 // Iterator creates a function to iterate the nodes of the Bst by returning the next (breadth-first) node on each call
-func (b *Bst) Iterator() func() (*Node, error) {
+func (b *Bst[K, V]) Iterator() func() (*Node[K, V], error) {
 	if b.IsEmpty() {
-		return func() (*Node, error) {
+		return func() (*Node[K, V], error) {
 			return nil, ErrIteratorStop
 		}
 	}
 
-	q := queue.NewQueue()
+	q := queue.NewQueue[*Node[K, V]]()
 	q.Push(b.Tree)
-	return func() (*Node, error) {
-		item, err := q.Pop()
+	return func() (*Node[K, V], error) {
+		curB, err := q.Pop()
 		if err == queue.ErrEmptyQueue {
 			return nil, ErrIteratorStop
 		}
-		curB, ok := item.(*Node)
-		if !ok {
-			return nil, errors.New("reached node of unknown type while traversing tree")
-		}
 		if curB == nil {
 			return curB, nil
 		}
@@ -196,14 +486,193 @@ func (b *Bst) Iterator() func() (*Node, error) {
 	}
 }
 
+// InOrder creates a function to iterate the nodes of the Bst by returning the next (ascending key order) node on each call
+func (b *Bst[K, V]) InOrder() func() (*Node[K, V], error) {
+	return inOrder(b.Tree, false, nil)
+}
+
+// ReverseInOrder creates a function to iterate the nodes of the Bst by returning the next (descending key order) node on each call
+func (b *Bst[K, V]) ReverseInOrder() func() (*Node[K, V], error) {
+	return inOrder(b.Tree, true, nil)
+}
+
+// PreOrder creates a function to iterate the nodes of the Bst by returning the next (depth-first, parent before children) node on each call
+func (b *Bst[K, V]) PreOrder() func() (*Node[K, V], error) {
+	s := stack.NewStack[*Node[K, V]]()
+	if !b.IsEmpty() {
+		s.Push(b.Tree)
+	}
+	return func() (*Node[K, V], error) {
+		curB, err := s.Pop()
+		if err == stack.ErrEmptyStack {
+			return nil, ErrIteratorStop
+		}
+		// push right first so that the left subtree is popped first
+		if curB.Right != nil {
+			s.Push(curB.Right)
+		}
+		if curB.Left != nil {
+			s.Push(curB.Left)
+		}
+		return curB, nil
+	}
+}
+
+// PostOrder creates a function to iterate the nodes of the Bst by returning the next (depth-first, children before parent) node on each call
+func (b *Bst[K, V]) PostOrder() func() (*Node[K, V], error) {
+	s := stack.NewStack[*Node[K, V]]()
+	curB := b.Tree
+	// lastB is the most recently returned node, which identifies a right subtree that has been completed
+	var lastB *Node[K, V]
+	return func() (*Node[K, V], error) {
+		for {
+			for curB != nil {
+				s.Push(curB)
+				curB = curB.Left
+			}
+			top, err := s.Peek()
+			if err == stack.ErrEmptyStack {
+				return nil, ErrIteratorStop
+			}
+			if top.Right != nil && top.Right != lastB {
+				curB = top.Right
+				continue
+			}
+			s.Pop()
+			lastB = top
+			return top, nil
+		}
+	}
+}
+
+// inOrder creates a function to iterate the nodes of the tree rooted at n in ascending key order, or
+// in descending key order when reverse; when afterStart is not nil, the walk begins at the first node
+// for which it returns true and skips the subtrees that lie entirely before that node
+func inOrder[K any, V any](n *Node[K, V], reverse bool, afterStart func(K) bool) func() (*Node[K, V], error) {
+	first := func(n *Node[K, V]) *Node[K, V] { return n.Left }
+	second := func(n *Node[K, V]) *Node[K, V] { return n.Right }
+	if reverse {
+		first, second = second, first
+	}
+
+	// the stack holds the ancestors yet to be returned
+	s := stack.NewStack[*Node[K, V]]()
+	pushPath := func(n *Node[K, V]) {
+		for n != nil {
+			if afterStart != nil && !afterStart(n.Key) {
+				n = second(n)
+				continue
+			}
+			s.Push(n)
+			n = first(n)
+		}
+	}
+	pushPath(n)
+
+	return func() (*Node[K, V], error) {
+		curB, err := s.Pop()
+		if err == stack.ErrEmptyStack {
+			return nil, ErrIteratorStop
+		}
+		pushPath(second(curB))
+		return curB, nil
+	}
+}
+
+// All returns an iterator over the key/value pairs of the Bst in ascending key order
+func (b *Bst[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := range inOrderSeq(b.Tree, false) {
+			if !yield(n.Key, n.Val) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the key/value pairs of the Bst in descending key order
+func (b *Bst[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := range inOrderSeq(b.Tree, true) {
+			if !yield(n.Key, n.Val) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the keys of the Bst in ascending order
+func (b *Bst[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for n := range inOrderSeq(b.Tree, false) {
+			if !yield(n.Key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values of the Bst in ascending order of their keys
+func (b *Bst[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for n := range inOrderSeq(b.Tree, false) {
+			if !yield(n.Val) {
+				return
+			}
+		}
+	}
+}
+
+// Level returns an iterator over the key/value pairs of the Bst in breadth-first order
+func (b *Bst[K, V]) Level() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if b.IsEmpty() {
+			return
+		}
+
+		q := queue.NewQueue[*Node[K, V]]()
+		q.Push(b.Tree)
+		for curB := range q.Drain() {
+			if !yield(curB.Key, curB.Val) {
+				q.Clear()
+				return
+			}
+			if curB.Left != nil {
+				q.Push(curB.Left)
+			}
+			if curB.Right != nil {
+				q.Push(curB.Right)
+			}
+		}
+	}
+}
+
+// inOrderSeq returns an iterator over the nodes of the tree rooted at n in ascending key order, or
+// in descending key order when reverse
+func inOrderSeq[K any, V any](n *Node[K, V], reverse bool) iter.Seq[*Node[K, V]] {
+	return func(yield func(*Node[K, V]) bool) {
+		next := inOrder(n, reverse, nil)
+		for {
+			curB, err := next()
+			if err == ErrIteratorStop {
+				return
+			}
+			if !yield(curB) {
+				return
+			}
+		}
+	}
+}
+
 // search searches for a key and returns the node, the parent node, and success bool
-func (b *Bst) search(key int64) (target *Node, parent *Node, found bool) {
+func (b *Bst[K, V]) search(key K) (target *Node[K, V], parent *Node[K, V], found bool) {
 	curTree := b.Tree
 	for {
-		if key == curTree.Key {
+		c := b.cmp(key, curTree.Key)
+		if c == 0 {
 			return curTree, parent, true
 		}
-		if key < curTree.Key {
+		if c < 0 {
 			if curTree.Left == nil {
 				return target, parent, false
 			}
@@ -219,35 +688,39 @@ func (b *Bst) search(key int64) (target *Node, parent *Node, found bool) {
 	}
 }
 
-func (b *Bst) deleteBySide(key int64, deleteSide side) error {
-	target, parent, found := b.search(key)
+// deleteBySide deletes a key/value pair, replacing a target with two children from the given side, and
+// returns the deleted value
+func (b *Bst[K, V]) deleteBySide(key K, deleteSide side) (val V, err error) {
+	target, _, found := b.search(key)
 	if !found {
-		return ErrKeyNotFound
+		return val, ErrKeyNotFound
 	}
+	target, parent := b.ownPath(target)
+
+	// exactly one node will be removed from below every node on the path from the root to target, so
+	// sizes can be updated before the tree is relinked
+	b.shrinkPath(target)
+	val = target.Val
 
 	// target is a leaf node
 	if target.Left == nil && target.Right == nil {
-		// cannot delete if target is both a root and leaf node
-		if parent == nil {
-			return ErrDeleteRootLeaf
-		}
-		parent.replaceChild(target, nil)
+		b.replaceChild(parent, target, nil)
 		target = nil
-		return nil
+		return val, nil
 	}
 
 	// target has only a right child
 	if target.Left == nil {
-		parent.replaceChild(target, target.Right)
+		b.replaceChild(parent, target, target.Right)
 		target = nil
-		return nil
+		return val, nil
 	}
 
 	// target has only a left child
 	if target.Right == nil {
-		parent.replaceChild(target, target.Left)
+		b.replaceChild(parent, target, target.Left)
 		target = nil
-		return nil
+		return val, nil
 	}
 
 	// target has both left and right children:
@@ -255,28 +728,126 @@ func (b *Bst) deleteBySide(key int64, deleteSide side) error {
 	// from left branch
 	switch deleteSide {
 	case leftSide:
-		deleteOnLeft(target)
+		b.deleteOnLeft(target)
 	case rightSide:
-		deleteOnRight(target)
-	default:
-		return fmt.Errorf("deleteSide must be one of [%v, %v], received [%v]", leftSide, rightSide, deleteSide)
+		b.deleteOnRight(target)
 	}
 
-	return nil
+	return val, nil
+}
+
+// rank returns the number of keys less than key and whether key is in the Bst
+func (b *Bst[K, V]) rank(key K) (rank int, found bool) {
+	curTree := b.Tree
+	for curTree != nil {
+		c := b.cmp(key, curTree.Key)
+		if c == 0 {
+			return rank + curTree.Left.Size(), true
+		}
+		if c < 0 {
+			curTree = curTree.Left
+			continue
+		}
+		rank += curTree.Left.Size() + 1
+		curTree = curTree.Right
+	}
+	return rank, false
 }
 
-func (n *Node) replaceChild(child *Node, newChild *Node) {
-	if child.Key < n.Key {
+// lower returns the node with the largest key less than key, or less than or equal to key when
+// inclusive, and nil if there is no such node
+func (b *Bst[K, V]) lower(key K, inclusive bool) (found *Node[K, V]) {
+	curTree := b.Tree
+	for curTree != nil {
+		c := b.cmp(key, curTree.Key)
+		if c == 0 && inclusive {
+			return curTree
+		}
+		if c <= 0 {
+			curTree = curTree.Left
+			continue
+		}
+		found = curTree
+		curTree = curTree.Right
+	}
+	return found
+}
+
+// upper returns the node with the smallest key greater than key, or greater than or equal to key
+// when inclusive, and nil if there is no such node
+func (b *Bst[K, V]) upper(key K, inclusive bool) (found *Node[K, V]) {
+	curTree := b.Tree
+	for curTree != nil {
+		c := b.cmp(key, curTree.Key)
+		if c == 0 && inclusive {
+			return curTree
+		}
+		if c >= 0 {
+			curTree = curTree.Right
+			continue
+		}
+		found = curTree
+		curTree = curTree.Left
+	}
+	return found
+}
+
+// result unpacks the key/value pair of a node found by a navigation query, reporting ErrEmpty for an
+// empty Bst and ErrKeyNotFound when no node satisfied the query
+func (b *Bst[K, V]) result(n *Node[K, V]) (key K, val V, err error) {
+	if b.IsEmpty() {
+		return key, val, ErrEmpty
+	}
+	if n == nil {
+		return key, val, ErrKeyNotFound
+	}
+	return n.Key, n.Val, nil
+}
+
+// shrinkPath decrements the size of every node on the path from the root to target inclusive
+func (b *Bst[K, V]) shrinkPath(target *Node[K, V]) {
+	curTree := b.Tree
+	for curTree != target {
+		curTree.size--
+		if b.cmp(target.Key, curTree.Key) < 0 {
+			curTree = curTree.Left
+			continue
+		}
+		curTree = curTree.Right
+	}
+	target.size--
+}
+
+// replaceChild links newChild in place of child, which is the root when parent is nil
+func (b *Bst[K, V]) replaceChild(parent *Node[K, V], child *Node[K, V], newChild *Node[K, V]) {
+	if parent == nil {
+		b.Tree = newChild
+		return
+	}
+	parent.replaceChild(child, newChild)
+}
+
+func (n *Node[K, V]) replaceChild(child *Node[K, V], newChild *Node[K, V]) {
+	if n.Left == child {
 		n.Left = newChild
 		return
 	}
 	n.Right = newChild
 }
 
-func deleteOnLeft(target *Node) {
-	right, parent := target.Left.findRightMost()
+func (b *Bst[K, V]) deleteOnLeft(target *Node[K, V]) {
+	// walk to the rightmost node of the left branch, copying any nodes shared with a snapshot since
+	// each loses a descendant and the last has its parent's link replaced
+	target.Left = b.own(target.Left)
+	var parent *Node[K, V]
+	right := target.Left
+	for right.Right != nil {
+		right.size--
+		right.Right = b.own(right.Right)
+		parent, right = right, right.Right
+	}
 
-	// overwrite target's key/value with left's key/value
+	// overwrite target's key/value with right's key/value
 	target.Key = right.Key
 	target.Val = right.Val
 
@@ -288,13 +859,22 @@ func deleteOnLeft(target *Node) {
 		return
 	}
 
-	// right and target.Left are the same, set both to nil
-	target.Left = nil
+	// right and target.Left are the same, so right's left subtree takes its place
+	target.Left = right.Left
 	right = nil
 }
 
-func deleteOnRight(target *Node) {
-	left, parent := target.Right.findLeftMost()
+func (b *Bst[K, V]) deleteOnRight(target *Node[K, V]) {
+	// walk to the leftmost node of the right branch, copying any nodes shared with a snapshot since
+	// each loses a descendant and the last has its parent's link replaced
+	target.Right = b.own(target.Right)
+	var parent *Node[K, V]
+	left := target.Right
+	for left.Left != nil {
+		left.size--
+		left.Left = b.own(left.Left)
+		parent, left = left, left.Left
+	}
 
 	// overwrite target's key/value with left's key/value
 	target.Key = left.Key
@@ -308,12 +888,12 @@ func deleteOnRight(target *Node) {
 		return
 	}
 
-	// left and target.Right are the same, set both to nil
-	target.Right = nil
+	// left and target.Right are the same, so left's right subtree takes its place
+	target.Right = left.Right
 	left = nil
 }
 
-func (n *Node) findLeftMost() (left *Node, parent *Node) {
+func (n *Node[K, V]) findLeftMost() (left *Node[K, V], parent *Node[K, V]) {
 	left, parent = n, nil
 	for {
 		if left.Left == nil {
@@ -324,7 +904,7 @@ func (n *Node) findLeftMost() (left *Node, parent *Node) {
 	}
 }
 
-func (n *Node) findRightMost() (right *Node, parent *Node) {
+func (n *Node[K, V]) findRightMost() (right *Node[K, V], parent *Node[K, V]) {
 	right, parent = n, nil
 	for {
 		if right.Right == nil {
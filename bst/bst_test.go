@@ -2,81 +2,22 @@ package bst
 
 import (
 	"fmt"
+	"iter"
+	"math/rand"
 	"testing"
 
-	"github.com/dkaslovsky/search-structures/queue"
 	"github.com/stretchr/testify/assert"
 )
 
-func equal(bst1 *Bst, bst2 *Bst) (eq bool, msg string) {
-	if bst1.IsEmpty() || bst2.IsEmpty() {
-		if bst1.IsEmpty() && bst2.IsEmpty() {
-			return true, ""
-		}
-		return false, "found one empty and one nonempty tree"
-	}
-
-	if bst1.IsEmpty() {
-		if bst2.IsEmpty() {
-			return true, ""
-		}
-	}
-
-	q1 := queue.NewQueue()
-	q1.Push(bst1.Tree)
-
-	q2 := queue.NewQueue()
-	q2.Push(bst2.Tree)
-
-	for {
-		item1, errItem1 := q1.Pop()
-		item2, errItem2 := q2.Pop()
-		if errItem1 == queue.ErrEmptyQueue && errItem2 == queue.ErrEmptyQueue {
-			return true, ""
-		}
-
-		b1, ok := item1.(*Node)
-		if !ok {
-			return false, fmt.Sprintf("error casting first argument tree node: %v", b1)
-		}
-		b2, ok := item2.(*Node)
-		if !ok {
-			return false, fmt.Sprintf("error casting second argument tree node: %v", b2)
-		}
-
-		if b1.Key != b2.Key || b1.Val != b2.Val {
-			return false, fmt.Sprintf("unequal nodes:\n%v\n%v", b1, b2)
-		}
-
-		if b1 == nil {
-			continue
-		}
-
-		if b1.Left != nil {
-			q1.Push(b1.Left)
-		}
-		if b1.Right != nil {
-			q1.Push(b1.Right)
-		}
-		if b2.Left != nil {
-			q2.Push(b2.Left)
-		}
-		if b2.Right != nil {
-			q2.Push(b2.Right)
-		}
-	}
-}
-
-// test equal so it can be used in subsequent assertions
 func TestEqual(t *testing.T) {
 	tests := map[string]struct {
-		tree1      *Bst
-		tree2      *Bst
+		tree1      *Bst[int, string]
+		tree2      *Bst[int, string]
 		expectedEq bool
 	}{
 		"empty trees": {
-			tree1:      NewBst(nil),
-			tree2:      NewBst(nil),
+			tree1:      NewBst[int, string](nil),
+			tree2:      NewBst[int, string](nil),
 			expectedEq: true,
 		},
 		"equal single node trees": {
@@ -181,6 +122,24 @@ func TestEqual(t *testing.T) {
 			),
 			expectedEq: false,
 		},
+		"unequal trees with same contents in different shapes": {
+			tree1: NewBst(
+				NewNode(20, "val20",
+					NewNode(10, "val10", nil, nil),
+					NewNode(30, "val30", nil, nil),
+				),
+			),
+			tree2: NewBst(
+				NewNode(10, "val10",
+					nil,
+					NewNode(20, "val20",
+						nil,
+						NewNode(30, "val30", nil, nil),
+					),
+				),
+			),
+			expectedEq: false,
+		},
 		"unequal trees with deep different children structure": {
 			tree1: NewBst(
 				NewNode(20, "val20",
@@ -212,18 +171,17 @@ func TestEqual(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			eq, _ := equal(test.tree1, test.tree2)
-			assert.Equal(t, test.expectedEq, eq)
+			assert.Equal(t, test.expectedEq, Equal(test.tree1, test.tree2))
 		})
 	}
 }
 
 func TestInsert(t *testing.T) {
 	tests := map[string]struct {
-		tree         *Bst
-		insertKey    int64
+		tree         *Bst[int, string]
+		insertKey    int
 		insertVal    string
-		expectedTree *Bst
+		expectedTree *Bst[int, string]
 	}{
 		"insert should be on left": {
 			tree:      NewBst(NewNode(10, "val10", nil, nil)),
@@ -394,11 +352,11 @@ func TestInsert(t *testing.T) {
 
 func TestIsEmpty(t *testing.T) {
 	tests := map[string]struct {
-		tree          *Bst
+		tree          *Bst[int, string]
 		expectedEmpty bool
 	}{
 		"empty tree": {
-			tree:          NewBst(nil),
+			tree:          NewBst[int, string](nil),
 			expectedEmpty: true,
 		},
 		"nonempty tree": {
@@ -416,13 +374,13 @@ func TestIsEmpty(t *testing.T) {
 
 func TestSearch(t *testing.T) {
 	tests := map[string]struct {
-		tree           *Bst
-		searchKey      int64
+		tree           *Bst[int, string]
+		searchKey      int
 		expectedValue  string
 		expectedExists bool
 	}{
 		"empty tree": {
-			tree:           NewBst(nil),
+			tree:           NewBst[int, string](nil),
 			searchKey:      1,
 			expectedValue:  "",
 			expectedExists: false,
@@ -479,24 +437,192 @@ func TestSearch(t *testing.T) {
 func TestDelete(t *testing.T) {
 	// Delete() calls deleteBySide() in all cases except for an empty tree
 	t.Run("empty tree", func(t *testing.T) {
-		tree := NewBst(nil)
+		tree := NewBst[int, string](nil)
 		err := tree.Delete(1)
 		assert.Equal(t, ErrEmpty, err)
 	})
+
+	t.Run("delete every node", func(t *testing.T) {
+		a := assert.New(t)
+		r := rand.New(rand.NewSource(1))
+		tree := NewBst[int, string](nil, WithRand(r))
+		keys := r.Perm(100)
+		for _, key := range keys {
+			tree.Insert(key, valFor(key))
+		}
+		for _, key := range r.Perm(100) {
+			a.NoError(tree.Delete(key))
+		}
+		a.True(tree.IsEmpty())
+		a.Equal(0, tree.Len())
+
+		// the emptied tree can be reused
+		tree.Insert(1, valFor(1))
+		val, found := tree.Search(1)
+		a.True(found)
+		a.Equal(valFor(1), val)
+	})
+}
+
+func TestTake(t *testing.T) {
+	tests := map[string]struct {
+		tree        *Bst[int, string]
+		takeKey     int
+		expectedErr error
+	}{
+		"empty tree": {
+			tree:        NewBst[int, string](nil),
+			takeKey:     10,
+			expectedErr: ErrEmpty,
+		},
+		"tree without takeKey": {
+			tree: NewBst(
+				NewNode(10, "val10",
+					NewNode(8, "val8", nil, nil),
+					NewNode(12, "val12", nil, nil),
+				),
+			),
+			takeKey:     1,
+			expectedErr: ErrKeyNotFound,
+		},
+		"tree with takeKey": {
+			tree: NewBst(
+				NewNode(10, "val10",
+					NewNode(8, "val8", nil, nil),
+					NewNode(12, "val12", nil, nil),
+				),
+			),
+			takeKey: 10,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			val, err := test.tree.Take(test.takeKey)
+			a.Equal(test.expectedErr, err)
+			if test.expectedErr != nil {
+				return
+			}
+			a.Equal(valFor(test.takeKey), val)
+			_, found := test.tree.Search(test.takeKey)
+			a.False(found)
+		})
+	}
+}
+
+func TestDeleteMinMax(t *testing.T) {
+	newTree := func() *Bst[int, string] {
+		return NewBst(
+			NewNode(20, "val20",
+				NewNode(10, "val10",
+					nil,
+					NewNode(15, "val15", nil, nil),
+				),
+				NewNode(30, "val30",
+					NewNode(25, "val25", nil, nil),
+					NewNode(40, "val40", nil, nil),
+				),
+			),
+		)
+	}
+
+	tests := map[string]struct {
+		deleteMin    bool
+		expectedKeys []int
+	}{
+		"DeleteMin": {
+			deleteMin:    true,
+			expectedKeys: []int{10, 15, 20, 25, 30, 40},
+		},
+		"DeleteMax": {
+			deleteMin:    false,
+			expectedKeys: []int{40, 30, 25, 20, 15, 10},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := newTree()
+			deleteFn := tree.DeleteMax
+			if test.deleteMin {
+				deleteFn = tree.DeleteMin
+			}
+
+			keys := []int{}
+			for !tree.IsEmpty() {
+				key, val, err := deleteFn()
+				a.NoError(err)
+				a.Equal(valFor(key), val)
+				keys = append(keys, key)
+				a.Equal(len(test.expectedKeys)-len(keys), tree.Len())
+				if tree.IsEmpty() {
+					break
+				}
+				valid, err := tree.Validate()
+				a.NoError(err)
+				a.True(valid)
+			}
+			a.Equal(test.expectedKeys, keys)
+
+			_, _, err := deleteFn()
+			a.Equal(ErrEmpty, err)
+		})
+	}
 }
 
 func TestDeleteBySide(t *testing.T) {
 	tests := map[string]struct {
-		tree         *Bst
+		tree         *Bst[int, string]
 		side         side
-		deleteKey    int64
-		expectedTree *Bst
+		deleteKey    int
+		expectedTree *Bst[int, string]
 		expectedErr  error
 	}{
 		"single node tree": {
-			tree:        NewBst(NewNode(10, "val10", nil, nil)),
-			deleteKey:   10,
-			expectedErr: ErrDeleteRootLeaf,
+			tree:         NewBst(NewNode(10, "val10", nil, nil)),
+			deleteKey:    10,
+			expectedTree: NewBst[int, string](nil),
+			expectedErr:  nil,
+		},
+		"root with only left child": {
+			tree: NewBst(
+				NewNode(10, "val10",
+					NewNode(8, "val8",
+						NewNode(6, "val6", nil, nil),
+						nil,
+					),
+					nil,
+				),
+			),
+			deleteKey: 10,
+			expectedTree: NewBst(
+				NewNode(8, "val8",
+					NewNode(6, "val6", nil, nil),
+					nil,
+				),
+			),
+			expectedErr: nil,
+		},
+		"root with only right child": {
+			tree: NewBst(
+				NewNode(10, "val10",
+					nil,
+					NewNode(12, "val12",
+						nil,
+						NewNode(14, "val14", nil, nil),
+					),
+				),
+			),
+			deleteKey: 10,
+			expectedTree: NewBst(
+				NewNode(12, "val12",
+					nil,
+					NewNode(14, "val14", nil, nil),
+				),
+			),
+			expectedErr: nil,
 		},
 		"multi node tree without deleteKey": {
 			tree: NewBst(
@@ -572,8 +698,48 @@ func TestDeleteBySide(t *testing.T) {
 			deleteKey: 5,
 			expectedTree: NewBst(
 				NewNode(10, "val10",
-					nil,
 					NewNode(7, "val7", nil, nil),
+					nil,
+				),
+			),
+			expectedErr: nil,
+		},
+		"leftSide delete with left child having only a left child": {
+			tree: NewBst(
+				NewNode(10, "val10",
+					NewNode(8, "val8",
+						NewNode(6, "val6", nil, nil),
+						nil,
+					),
+					NewNode(12, "val12", nil, nil),
+				),
+			),
+			side:      leftSide,
+			deleteKey: 10,
+			expectedTree: NewBst(
+				NewNode(8, "val8",
+					NewNode(6, "val6", nil, nil),
+					NewNode(12, "val12", nil, nil),
+				),
+			),
+			expectedErr: nil,
+		},
+		"rightSide delete with right child having only a right child": {
+			tree: NewBst(
+				NewNode(10, "val10",
+					NewNode(8, "val8", nil, nil),
+					NewNode(12, "val12",
+						nil,
+						NewNode(14, "val14", nil, nil),
+					),
+				),
+			),
+			side:      rightSide,
+			deleteKey: 10,
+			expectedTree: NewBst(
+				NewNode(12, "val12",
+					NewNode(8, "val8", nil, nil),
+					NewNode(14, "val14", nil, nil),
 				),
 			),
 			expectedErr: nil,
@@ -807,12 +973,16 @@ func TestDeleteBySide(t *testing.T) {
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			a := assert.New(t)
-			err := test.tree.deleteBySide(test.deleteKey, test.side)
+			val, err := test.tree.deleteBySide(test.deleteKey, test.side)
 			if err != nil {
 				a.Equal(test.expectedErr, err)
 				return
 			}
+			a.Equal(valFor(test.deleteKey), val)
 			assertBstEqual(t, test.expectedTree, test.tree)
+			if test.tree.IsEmpty() {
+				return
+			}
 
 			valid, err := test.tree.Validate()
 			a.NoError(err)
@@ -821,43 +991,20 @@ func TestDeleteBySide(t *testing.T) {
 	}
 }
 
-func TestValidate(t *testing.T) {
+func TestLen(t *testing.T) {
 	tests := map[string]struct {
-		tree          *Bst
-		expectedValid bool
-		expectedErr   error
+		tree        *Bst[int, string]
+		expectedLen int
 	}{
 		"empty tree": {
-			tree:          NewBst(nil),
-			expectedValid: false,
-			expectedErr:   ErrEmpty,
+			tree:        NewBst[int, string](nil),
+			expectedLen: 0,
 		},
 		"single node tree": {
-			tree:          NewBst(NewNode(10, "val10", nil, nil)),
-			expectedValid: true,
-			expectedErr:   nil,
-		},
-		"multi node valid tree": {
-			tree: NewBst(
-				NewNode(10, "val10",
-					NewNode(8, "val8", nil, nil),
-					NewNode(12, "val12", nil, nil),
-				),
-			),
-			expectedValid: true,
-			expectedErr:   nil,
-		},
-		"multi node invalid tree": {
-			tree: NewBst(
-				NewNode(10, "val1",
-					NewNode(11, "va11", nil, nil),
-					NewNode(12, "val12", nil, nil),
-				),
-			),
-			expectedValid: false,
-			expectedErr:   nil,
+			tree:        NewBst(NewNode(10, "val10", nil, nil)),
+			expectedLen: 1,
 		},
-		"deep multi node valid tree": {
+		"deep multi node tree": {
 			tree: NewBst(
 				NewNode(20, "val20",
 					NewNode(10, "val10",
@@ -870,67 +1017,56 @@ func TestValidate(t *testing.T) {
 					),
 				),
 			),
-			expectedValid: true,
-			expectedErr:   nil,
-		},
-		"deep multi node invalid tree": {
-			tree: NewBst(
-				NewNode(20, "val20",
-					NewNode(10, "val10",
-						nil,
-						NewNode(15, "val15", nil, nil),
-					),
-					NewNode(30, "val30",
-						NewNode(25, "val25", nil, nil),
-						NewNode(24, "val24", nil, nil),
-					),
-				),
-			),
-			expectedValid: false,
-			expectedErr:   nil,
+			expectedLen: 6,
 		},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			a := assert.New(t)
-			valid, err := test.tree.Validate()
-			a.Equal(test.expectedValid, valid)
-			a.Equal(test.expectedErr, err)
+			assert.Equal(t, test.expectedLen, test.tree.Len())
 		})
 	}
 }
 
-func TestIterator(t *testing.T) {
-	type testNode struct {
-		key int64
-		val string
+func TestSizeMaintained(t *testing.T) {
+	a := assert.New(t)
+	r := rand.New(rand.NewSource(1))
+	tree := NewBst[int, string](nil, WithRand(r))
+
+	keys := r.Perm(500)
+	for _, key := range keys {
+		tree.Insert(key, valFor(key))
+	}
+	// overwriting existing keys must not change sizes
+	for _, key := range keys[:100] {
+		tree.Insert(key, valFor(key))
+	}
+	a.Equal(500, tree.Len())
+
+	for i, key := range keys[:250] {
+		a.NoError(tree.Delete(key))
+		a.Equal(500-i-1, tree.Len())
 	}
+	valid, err := tree.Validate()
+	a.NoError(err)
+	a.True(valid)
+}
 
+func TestMinMax(t *testing.T) {
 	tests := map[string]struct {
-		tree                  *Bst
-		expectedIteratedNodes []testNode
+		tree        *Bst[int, string]
+		expectedMin int
+		expectedMax int
+		expectedErr error
 	}{
 		"empty tree": {
-			tree:                  NewBst(nil),
-			expectedIteratedNodes: []testNode{},
+			tree:        NewBst[int, string](nil),
+			expectedErr: ErrEmpty,
 		},
 		"single node tree": {
-			tree:                  NewBst(NewNode(10, "val10", nil, nil)),
-			expectedIteratedNodes: []testNode{testNode{10, "val10"}},
-		},
-		"multi node tree": {
-			tree: NewBst(
-				NewNode(10, "val10",
-					NewNode(8, "val8", nil, nil),
-					NewNode(12, "val12", nil, nil),
-				),
-			),
-			expectedIteratedNodes: []testNode{
-				testNode{10, "val10"},
-				testNode{8, "val8"},
-				testNode{12, "val12"},
-			},
+			tree:        NewBst(NewNode(10, "val10", nil, nil)),
+			expectedMin: 10,
+			expectedMax: 10,
 		},
 		"deep multi node tree": {
 			tree: NewBst(
@@ -945,11 +1081,616 @@ func TestIterator(t *testing.T) {
 					),
 				),
 			),
-			expectedIteratedNodes: []testNode{
-				testNode{20, "val20"},
-				testNode{10, "val10"},
-				testNode{30, "val30"},
-				testNode{15, "val15"},
+			expectedMin: 10,
+			expectedMax: 40,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+
+			key, val, err := test.tree.Min()
+			a.Equal(test.expectedErr, err)
+			if test.expectedErr == nil {
+				a.Equal(test.expectedMin, key)
+				a.Equal(valFor(test.expectedMin), val)
+			}
+
+			key, val, err = test.tree.Max()
+			a.Equal(test.expectedErr, err)
+			if test.expectedErr == nil {
+				a.Equal(test.expectedMax, key)
+				a.Equal(valFor(test.expectedMax), val)
+			}
+		})
+	}
+}
+
+func TestNavigation(t *testing.T) {
+	tree := NewBst(
+		NewNode(20, "val20",
+			NewNode(10, "val10",
+				nil,
+				NewNode(15, "val15", nil, nil),
+			),
+			NewNode(30, "val30",
+				NewNode(25, "val25", nil, nil),
+				NewNode(40, "val40", nil, nil),
+			),
+		),
+	)
+
+	type query func(*Bst[int, string], int) (int, string, error)
+	floor := (*Bst[int, string]).Floor
+	ceiling := (*Bst[int, string]).Ceiling
+	predecessor := (*Bst[int, string]).Predecessor
+	successor := (*Bst[int, string]).Successor
+
+	tests := map[string]struct {
+		tree        *Bst[int, string]
+		query       query
+		key         int
+		expectedKey int
+		expectedErr error
+	}{
+		"floor on empty tree": {
+			tree:        NewBst[int, string](nil),
+			query:       floor,
+			key:         10,
+			expectedErr: ErrEmpty,
+		},
+		"floor of key in tree": {
+			tree:        tree,
+			query:       floor,
+			key:         25,
+			expectedKey: 25,
+		},
+		"floor of key not in tree": {
+			tree:        tree,
+			query:       floor,
+			key:         28,
+			expectedKey: 25,
+		},
+		"floor of key below all keys": {
+			tree:        tree,
+			query:       floor,
+			key:         5,
+			expectedErr: ErrKeyNotFound,
+		},
+		"ceiling on empty tree": {
+			tree:        NewBst[int, string](nil),
+			query:       ceiling,
+			key:         10,
+			expectedErr: ErrEmpty,
+		},
+		"ceiling of key in tree": {
+			tree:        tree,
+			query:       ceiling,
+			key:         15,
+			expectedKey: 15,
+		},
+		"ceiling of key not in tree": {
+			tree:        tree,
+			query:       ceiling,
+			key:         16,
+			expectedKey: 20,
+		},
+		"ceiling of key above all keys": {
+			tree:        tree,
+			query:       ceiling,
+			key:         41,
+			expectedErr: ErrKeyNotFound,
+		},
+		"predecessor on empty tree": {
+			tree:        NewBst[int, string](nil),
+			query:       predecessor,
+			key:         10,
+			expectedErr: ErrEmpty,
+		},
+		"predecessor of key with left subtree": {
+			tree:        tree,
+			query:       predecessor,
+			key:         20,
+			expectedKey: 15,
+		},
+		"predecessor of key without left subtree": {
+			tree:        tree,
+			query:       predecessor,
+			key:         25,
+			expectedKey: 20,
+		},
+		"predecessor of key not in tree": {
+			tree:        tree,
+			query:       predecessor,
+			key:         26,
+			expectedKey: 25,
+		},
+		"predecessor of smallest key": {
+			tree:        tree,
+			query:       predecessor,
+			key:         10,
+			expectedErr: ErrKeyNotFound,
+		},
+		"successor on empty tree": {
+			tree:        NewBst[int, string](nil),
+			query:       successor,
+			key:         10,
+			expectedErr: ErrEmpty,
+		},
+		"successor of key with right subtree": {
+			tree:        tree,
+			query:       successor,
+			key:         20,
+			expectedKey: 25,
+		},
+		"successor of key without right subtree": {
+			tree:        tree,
+			query:       successor,
+			key:         15,
+			expectedKey: 20,
+		},
+		"successor of key not in tree": {
+			tree:        tree,
+			query:       successor,
+			key:         31,
+			expectedKey: 40,
+		},
+		"successor of largest key": {
+			tree:        tree,
+			query:       successor,
+			key:         40,
+			expectedErr: ErrKeyNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			key, val, err := test.query(test.tree, test.key)
+			a.Equal(test.expectedErr, err)
+			if test.expectedErr != nil {
+				return
+			}
+			a.Equal(test.expectedKey, key)
+			a.Equal(valFor(test.expectedKey), val)
+		})
+	}
+}
+
+func TestSelect(t *testing.T) {
+	tree := NewBst(
+		NewNode(20, "val20",
+			NewNode(10, "val10",
+				nil,
+				NewNode(15, "val15", nil, nil),
+			),
+			NewNode(30, "val30",
+				NewNode(25, "val25", nil, nil),
+				NewNode(40, "val40", nil, nil),
+			),
+		),
+	)
+
+	tests := map[string]struct {
+		tree        *Bst[int, string]
+		k           int
+		expectedKey int
+		expectedErr error
+	}{
+		"empty tree": {
+			tree:        NewBst[int, string](nil),
+			k:           0,
+			expectedErr: ErrEmpty,
+		},
+		"negative index": {
+			tree:        tree,
+			k:           -1,
+			expectedErr: ErrOutOfRange,
+		},
+		"index equal to length": {
+			tree:        tree,
+			k:           6,
+			expectedErr: ErrOutOfRange,
+		},
+		"smallest key": {
+			tree:        tree,
+			k:           0,
+			expectedKey: 10,
+		},
+		"key in left subtree": {
+			tree:        tree,
+			k:           1,
+			expectedKey: 15,
+		},
+		"root key": {
+			tree:        tree,
+			k:           2,
+			expectedKey: 20,
+		},
+		"key in right subtree": {
+			tree:        tree,
+			k:           3,
+			expectedKey: 25,
+		},
+		"largest key": {
+			tree:        tree,
+			k:           5,
+			expectedKey: 40,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			key, val, err := test.tree.Select(test.k)
+			a.Equal(test.expectedErr, err)
+			if test.expectedErr != nil {
+				return
+			}
+			a.Equal(test.expectedKey, key)
+			a.Equal(valFor(test.expectedKey), val)
+		})
+	}
+}
+
+func TestRank(t *testing.T) {
+	tree := NewBst(
+		NewNode(20, "val20",
+			NewNode(10, "val10",
+				nil,
+				NewNode(15, "val15", nil, nil),
+			),
+			NewNode(30, "val30",
+				NewNode(25, "val25", nil, nil),
+				NewNode(40, "val40", nil, nil),
+			),
+		),
+	)
+
+	tests := map[string]struct {
+		tree         *Bst[int, string]
+		key          int
+		expectedRank int
+	}{
+		"empty tree": {
+			tree:         NewBst[int, string](nil),
+			key:          10,
+			expectedRank: 0,
+		},
+		"key below all keys": {
+			tree:         tree,
+			key:          5,
+			expectedRank: 0,
+		},
+		"smallest key": {
+			tree:         tree,
+			key:          10,
+			expectedRank: 0,
+		},
+		"root key": {
+			tree:         tree,
+			key:          20,
+			expectedRank: 2,
+		},
+		"key not in tree": {
+			tree:         tree,
+			key:          27,
+			expectedRank: 4,
+		},
+		"largest key": {
+			tree:         tree,
+			key:          40,
+			expectedRank: 5,
+		},
+		"key above all keys": {
+			tree:         tree,
+			key:          50,
+			expectedRank: 6,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expectedRank, test.tree.Rank(test.key))
+		})
+	}
+}
+
+func TestSelectRankInverse(t *testing.T) {
+	a := assert.New(t)
+	tree := NewBst[int, string](nil)
+	for _, key := range rand.New(rand.NewSource(1)).Perm(200) {
+		tree.Insert(key, valFor(key))
+	}
+	for k := 0; k < tree.Len(); k++ {
+		key, _, err := tree.Select(k)
+		a.NoError(err)
+		a.Equal(k, tree.Rank(key))
+	}
+}
+
+func TestRange(t *testing.T) {
+	tree := NewBst(
+		NewNode(20, "val20",
+			NewNode(10, "val10",
+				nil,
+				NewNode(15, "val15", nil, nil),
+			),
+			NewNode(30, "val30",
+				NewNode(25, "val25", nil, nil),
+				NewNode(40, "val40", nil, nil),
+			),
+		),
+	)
+
+	tests := map[string]struct {
+		tree         *Bst[int, string]
+		lo           int
+		hi           int
+		opts         []RangeOption
+		expectedKeys []int
+	}{
+		"empty tree": {
+			tree:         NewBst[int, string](nil),
+			lo:           0,
+			hi:           100,
+			expectedKeys: []int{},
+		},
+		"range covering all keys": {
+			tree:         tree,
+			lo:           0,
+			hi:           100,
+			expectedKeys: []int{10, 15, 20, 25, 30, 40},
+		},
+		"inclusive bounds in tree": {
+			tree:         tree,
+			lo:           15,
+			hi:           30,
+			expectedKeys: []int{15, 20, 25, 30},
+		},
+		"bounds not in tree": {
+			tree:         tree,
+			lo:           16,
+			hi:           29,
+			expectedKeys: []int{20, 25},
+		},
+		"exclusive lower bound": {
+			tree:         tree,
+			lo:           15,
+			hi:           30,
+			opts:         []RangeOption{WithExclusiveLo()},
+			expectedKeys: []int{20, 25, 30},
+		},
+		"exclusive upper bound": {
+			tree:         tree,
+			lo:           15,
+			hi:           30,
+			opts:         []RangeOption{WithExclusiveHi()},
+			expectedKeys: []int{15, 20, 25},
+		},
+		"exclusive bounds": {
+			tree:         tree,
+			lo:           15,
+			hi:           30,
+			opts:         []RangeOption{WithExclusiveLo(), WithExclusiveHi()},
+			expectedKeys: []int{20, 25},
+		},
+		"reverse": {
+			tree:         tree,
+			lo:           15,
+			hi:           30,
+			opts:         []RangeOption{WithReverse()},
+			expectedKeys: []int{30, 25, 20, 15},
+		},
+		"reverse with exclusive bounds": {
+			tree:         tree,
+			lo:           15,
+			hi:           30,
+			opts:         []RangeOption{WithReverse(), WithExclusiveLo(), WithExclusiveHi()},
+			expectedKeys: []int{25, 20},
+		},
+		"single key range": {
+			tree:         tree,
+			lo:           25,
+			hi:           25,
+			expectedKeys: []int{25},
+		},
+		"exclusive single key range": {
+			tree:         tree,
+			lo:           25,
+			hi:           25,
+			opts:         []RangeOption{WithExclusiveLo()},
+			expectedKeys: []int{},
+		},
+		"range between keys": {
+			tree:         tree,
+			lo:           26,
+			hi:           29,
+			expectedKeys: []int{},
+		},
+		"lower bound above upper bound": {
+			tree:         tree,
+			lo:           30,
+			hi:           15,
+			expectedKeys: []int{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			a.Equal(test.expectedKeys, iteratedKeys(test.tree.Range(test.lo, test.hi, test.opts...)))
+			a.Equal(len(test.expectedKeys), test.tree.RangeCount(test.lo, test.hi, test.opts...))
+		})
+	}
+}
+
+func TestRangeRandom(t *testing.T) {
+	a := assert.New(t)
+	r := rand.New(rand.NewSource(1))
+	tree := NewBst[int, string](nil)
+	for _, key := range r.Perm(100) {
+		// only even keys so that bounds are sometimes absent from the tree
+		tree.Insert(2*key, valFor(2*key))
+	}
+
+	for i := 0; i < 100; i++ {
+		lo, hi := r.Intn(220)-10, r.Intn(220)-10
+		expectedKeys := []int{}
+		for key := 0; key < 200; key += 2 {
+			if key > lo && key < hi {
+				expectedKeys = append(expectedKeys, key)
+			}
+		}
+		opts := []RangeOption{WithExclusiveLo(), WithExclusiveHi()}
+		a.Equal(expectedKeys, iteratedKeys(tree.Range(lo, hi, opts...)))
+		a.Equal(len(expectedKeys), tree.RangeCount(lo, hi, opts...))
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		tree          *Bst[int, string]
+		expectedValid bool
+		expectedErr   error
+	}{
+		"empty tree": {
+			tree:          NewBst[int, string](nil),
+			expectedValid: false,
+			expectedErr:   ErrEmpty,
+		},
+		"single node tree": {
+			tree:          NewBst(NewNode(10, "val10", nil, nil)),
+			expectedValid: true,
+			expectedErr:   nil,
+		},
+		"multi node valid tree": {
+			tree: NewBst(
+				NewNode(10, "val10",
+					NewNode(8, "val8", nil, nil),
+					NewNode(12, "val12", nil, nil),
+				),
+			),
+			expectedValid: true,
+			expectedErr:   nil,
+		},
+		"multi node invalid tree": {
+			tree: NewBst(
+				NewNode(10, "val1",
+					NewNode(11, "va11", nil, nil),
+					NewNode(12, "val12", nil, nil),
+				),
+			),
+			expectedValid: false,
+			expectedErr:   nil,
+		},
+		"deep multi node valid tree": {
+			tree: NewBst(
+				NewNode(20, "val20",
+					NewNode(10, "val10",
+						nil,
+						NewNode(15, "val15", nil, nil),
+					),
+					NewNode(30, "val30",
+						NewNode(25, "val25", nil, nil),
+						NewNode(40, "val40", nil, nil),
+					),
+				),
+			),
+			expectedValid: true,
+			expectedErr:   nil,
+		},
+		"deep multi node invalid tree": {
+			tree: NewBst(
+				NewNode(20, "val20",
+					NewNode(10, "val10",
+						nil,
+						NewNode(15, "val15", nil, nil),
+					),
+					NewNode(30, "val30",
+						NewNode(25, "val25", nil, nil),
+						NewNode(24, "val24", nil, nil),
+					),
+				),
+			),
+			expectedValid: false,
+			expectedErr:   nil,
+		},
+		"multi node tree with incorrect subtree size": {
+			tree: NewBst(
+				&Node[int, string]{
+					Key:   10,
+					Val:   "val10",
+					Left:  NewNode(8, "val8", nil, nil),
+					Right: NewNode(12, "val12", nil, nil),
+					size:  2,
+				},
+			),
+			expectedValid: false,
+			expectedErr:   nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			valid, err := test.tree.Validate()
+			a.Equal(test.expectedValid, valid)
+			a.Equal(test.expectedErr, err)
+		})
+	}
+}
+
+func TestIterator(t *testing.T) {
+	type testNode struct {
+		key int
+		val string
+	}
+
+	tests := map[string]struct {
+		tree                  *Bst[int, string]
+		expectedIteratedNodes []testNode
+	}{
+		"empty tree": {
+			tree:                  NewBst[int, string](nil),
+			expectedIteratedNodes: []testNode{},
+		},
+		"single node tree": {
+			tree:                  NewBst(NewNode(10, "val10", nil, nil)),
+			expectedIteratedNodes: []testNode{testNode{10, "val10"}},
+		},
+		"multi node tree": {
+			tree: NewBst(
+				NewNode(10, "val10",
+					NewNode(8, "val8", nil, nil),
+					NewNode(12, "val12", nil, nil),
+				),
+			),
+			expectedIteratedNodes: []testNode{
+				testNode{10, "val10"},
+				testNode{8, "val8"},
+				testNode{12, "val12"},
+			},
+		},
+		"deep multi node tree": {
+			tree: NewBst(
+				NewNode(20, "val20",
+					NewNode(10, "val10",
+						nil,
+						NewNode(15, "val15", nil, nil),
+					),
+					NewNode(30, "val30",
+						NewNode(25, "val25", nil, nil),
+						NewNode(40, "val40", nil, nil),
+					),
+				),
+			),
+			expectedIteratedNodes: []testNode{
+				testNode{20, "val20"},
+				testNode{10, "val10"},
+				testNode{30, "val30"},
+				testNode{15, "val15"},
 				testNode{25, "val25"},
 				testNode{40, "val40"},
 			},
@@ -974,10 +1715,350 @@ func TestIterator(t *testing.T) {
 	}
 }
 
-func assertBstEqual(t *testing.T, bst1 *Bst, bst2 *Bst) {
+func TestDepthFirstIterators(t *testing.T) {
+	tests := map[string]struct {
+		tree                   *Bst[int, string]
+		expectedInOrder        []int
+		expectedReverseInOrder []int
+		expectedPreOrder       []int
+		expectedPostOrder      []int
+	}{
+		"empty tree": {
+			tree:                   NewBst[int, string](nil),
+			expectedInOrder:        []int{},
+			expectedReverseInOrder: []int{},
+			expectedPreOrder:       []int{},
+			expectedPostOrder:      []int{},
+		},
+		"single node tree": {
+			tree:                   NewBst(NewNode(10, "val10", nil, nil)),
+			expectedInOrder:        []int{10},
+			expectedReverseInOrder: []int{10},
+			expectedPreOrder:       []int{10},
+			expectedPostOrder:      []int{10},
+		},
+		"deep multi node tree": {
+			tree: NewBst(
+				NewNode(20, "val20",
+					NewNode(10, "val10",
+						nil,
+						NewNode(15, "val15", nil, nil),
+					),
+					NewNode(30, "val30",
+						NewNode(25, "val25", nil, nil),
+						NewNode(40, "val40",
+							NewNode(32, "val32", nil,
+								NewNode(34, "val34", nil, nil),
+							),
+							NewNode(42, "val42", nil, nil),
+						),
+					),
+				),
+			),
+			expectedInOrder:        []int{10, 15, 20, 25, 30, 32, 34, 40, 42},
+			expectedReverseInOrder: []int{42, 40, 34, 32, 30, 25, 20, 15, 10},
+			expectedPreOrder:       []int{20, 10, 15, 30, 25, 40, 32, 34, 42},
+			expectedPostOrder:      []int{15, 10, 25, 34, 32, 42, 40, 30, 20},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			a.Equal(test.expectedInOrder, iteratedKeys(test.tree.InOrder()))
+			a.Equal(test.expectedReverseInOrder, iteratedKeys(test.tree.ReverseInOrder()))
+			a.Equal(test.expectedPreOrder, iteratedKeys(test.tree.PreOrder()))
+			a.Equal(test.expectedPostOrder, iteratedKeys(test.tree.PostOrder()))
+		})
+	}
+}
+
+func TestSeqIterators(t *testing.T) {
+	tests := map[string]struct {
+		tree                 *Bst[int, string]
+		expectedKeys         []int
+		expectedBackwardKeys []int
+		expectedLevelKeys    []int
+	}{
+		"empty tree": {
+			tree:                 NewBst[int, string](nil),
+			expectedKeys:         []int{},
+			expectedBackwardKeys: []int{},
+			expectedLevelKeys:    []int{},
+		},
+		"single node tree": {
+			tree:                 NewBst(NewNode(10, "val10", nil, nil)),
+			expectedKeys:         []int{10},
+			expectedBackwardKeys: []int{10},
+			expectedLevelKeys:    []int{10},
+		},
+		"deep multi node tree": {
+			tree: NewBst(
+				NewNode(20, "val20",
+					NewNode(10, "val10",
+						nil,
+						NewNode(15, "val15", nil, nil),
+					),
+					NewNode(30, "val30",
+						NewNode(25, "val25", nil, nil),
+						NewNode(40, "val40", nil, nil),
+					),
+				),
+			),
+			expectedKeys:         []int{10, 15, 20, 25, 30, 40},
+			expectedBackwardKeys: []int{40, 30, 25, 20, 15, 10},
+			expectedLevelKeys:    []int{20, 10, 30, 15, 25, 40},
+		},
+	}
+
+	collect := func(seq iter.Seq2[int, string]) []int {
+		keys := []int{}
+		for key, val := range seq {
+			assert.Equal(t, valFor(key), val)
+			keys = append(keys, key)
+		}
+		return keys
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			a.Equal(test.expectedKeys, collect(test.tree.All()))
+			a.Equal(test.expectedBackwardKeys, collect(test.tree.Backward()))
+			a.Equal(test.expectedLevelKeys, collect(test.tree.Level()))
+
+			keys := []int{}
+			for key := range test.tree.Keys() {
+				keys = append(keys, key)
+			}
+			a.Equal(test.expectedKeys, keys)
+
+			vals := []string{}
+			for val := range test.tree.Values() {
+				vals = append(vals, val)
+			}
+			expectedVals := []string{}
+			for _, key := range test.expectedKeys {
+				expectedVals = append(expectedVals, valFor(key))
+			}
+			a.Equal(expectedVals, vals)
+		})
+	}
+}
+
+func TestSeqIteratorsBreak(t *testing.T) {
+	tree := NewBst(
+		NewNode(20, "val20",
+			NewNode(10, "val10",
+				nil,
+				NewNode(15, "val15", nil, nil),
+			),
+			NewNode(30, "val30",
+				NewNode(25, "val25", nil, nil),
+				NewNode(40, "val40", nil, nil),
+			),
+		),
+	)
+
+	tests := map[string]struct {
+		seq          iter.Seq2[int, string]
+		expectedKeys []int
+	}{
+		"All": {
+			seq:          tree.All(),
+			expectedKeys: []int{10, 15, 20},
+		},
+		"Backward": {
+			seq:          tree.Backward(),
+			expectedKeys: []int{40, 30, 25},
+		},
+		"Level": {
+			seq:          tree.Level(),
+			expectedKeys: []int{20, 10, 30},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			keys := []int{}
+			for key := range test.seq {
+				keys = append(keys, key)
+				if len(keys) == 3 {
+					break
+				}
+			}
+			assert.Equal(t, test.expectedKeys, keys)
+		})
+	}
+}
+
+func TestDeleteStrategy(t *testing.T) {
+	newTree := func(opts ...Option) *Bst[int, string] {
+		return NewBst(
+			NewNode(20, "val20",
+				NewNode(10, "val10",
+					nil,
+					NewNode(15, "val15", nil, nil),
+				),
+				NewNode(30, "val30",
+					NewNode(25, "val25", nil, nil),
+					NewNode(40, "val40", nil, nil),
+				),
+			),
+			opts...,
+		)
+	}
+
+	tests := map[string]struct {
+		opts             []Option
+		expectedStrategy DeleteStrategy
+		// expectedRoots are the root keys after each successive deletion of the root
+		expectedRoots []int
+	}{
+		"default strategy": {
+			opts:             []Option{},
+			expectedStrategy: DeleteRandom,
+		},
+		"left strategy": {
+			opts:             []Option{WithDeleteStrategy(DeleteLeft)},
+			expectedStrategy: DeleteLeft,
+			expectedRoots:    []int{15, 10, 30},
+		},
+		"right strategy": {
+			opts:             []Option{WithDeleteStrategy(DeleteRight)},
+			expectedStrategy: DeleteRight,
+			expectedRoots:    []int{25, 30, 40},
+		},
+		"alternating strategy": {
+			opts:             []Option{WithDeleteStrategy(DeleteAlternating)},
+			expectedStrategy: DeleteAlternating,
+			expectedRoots:    []int{15, 25, 10},
+		},
+		"unknown strategy is ignored": {
+			opts:             []Option{WithDeleteStrategy(DeleteAlternating + 1)},
+			expectedStrategy: DeleteRandom,
+		},
+		"unknown strategy leaves previous strategy": {
+			opts:             []Option{WithDeleteStrategy(DeleteRight), WithDeleteStrategy(DeleteAlternating + 1)},
+			expectedStrategy: DeleteRight,
+			expectedRoots:    []int{25, 30, 40},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := newTree(test.opts...)
+			a.Equal(test.expectedStrategy, tree.DeleteStrategy())
+
+			for _, expectedRoot := range test.expectedRoots {
+				a.NoError(tree.Delete(tree.Tree.Key))
+				a.Equal(expectedRoot, tree.Tree.Key)
+
+				valid, err := tree.Validate()
+				a.NoError(err)
+				a.True(valid)
+			}
+		})
+	}
+}
+
+func TestWithSeed(t *testing.T) {
 	a := assert.New(t)
-	eq, msg := equal(bst1, bst2)
-	if !eq {
-		a.Fail(msg)
+	keys := rand.New(rand.NewSource(1)).Perm(200)
+	deleteKeys := rand.New(rand.NewSource(2)).Perm(200)[:100]
+
+	// trees built with the same seed make the same random choices
+	trees := []*Bst[int, string]{}
+	for i := 0; i < 2; i++ {
+		tree := NewBst[int, string](nil, WithSeed(42))
+		for _, key := range keys {
+			tree.Insert(key, valFor(key))
+		}
+		for _, key := range deleteKeys {
+			a.NoError(tree.Delete(key))
+		}
+		trees = append(trees, tree)
+	}
+	assertBstEqual(t, trees[0], trees[1])
+}
+
+func TestNewBstFunc(t *testing.T) {
+	type record struct {
+		id   int
+		name string
+	}
+
+	t.Run("struct keys", func(t *testing.T) {
+		a := assert.New(t)
+		tree := NewBstFunc[record, string](nil, func(r1, r2 record) int {
+			return r1.id - r2.id
+		})
+		tree.Insert(record{20, "b"}, "val20")
+		tree.Insert(record{10, "a"}, "val10")
+		tree.Insert(record{30, "c"}, "val30")
+
+		val, found := tree.Search(record{id: 10})
+		a.True(found)
+		a.Equal("val10", val)
+
+		valid, err := tree.Validate()
+		a.NoError(err)
+		a.True(valid)
+	})
+
+	t.Run("reverse ordering", func(t *testing.T) {
+		a := assert.New(t)
+		tree := NewBstFunc[int, string](nil, func(k1, k2 int) int {
+			return k2 - k1
+		})
+		tree.Insert(10, "val10")
+		tree.Insert(20, "val20")
+		tree.Insert(5, "val5")
+		assertBstEqual(t, tree, NewBst(
+			NewNode(10, "val10",
+				NewNode(20, "val20", nil, nil),
+				NewNode(5, "val5", nil, nil),
+			),
+		))
+
+		valid, err := tree.Validate()
+		a.NoError(err)
+		a.True(valid)
+
+		// the natural ordering considers the same tree invalid
+		valid, err = NewBst(tree.Tree).Validate()
+		a.NoError(err)
+		a.False(valid)
+	})
+}
+
+func assertBstEqual[K any, V comparable](t *testing.T, bst1 *Bst[K, V], bst2 *Bst[K, V]) {
+	t.Helper()
+	if !Equal(bst1, bst2) {
+		assert.Fail(t, "unequal trees", "%v\n%v", levelPairs(bst1), levelPairs(bst2))
+	}
+}
+
+func levelPairs[K any, V any](tree *Bst[K, V]) []string {
+	pairs := []string{}
+	for key, val := range tree.Level() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", key, val))
+	}
+	return pairs
+}
+
+func valFor(key int) string {
+	return fmt.Sprintf("val%d", key)
+}
+
+func iteratedKeys(iter func() (*Node[int, string], error)) []int {
+	keys := []int{}
+	for {
+		node, err := iter()
+		if err == ErrIteratorStop {
+			return keys
+		}
+		keys = append(keys, node.Key)
 	}
 }
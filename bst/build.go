@@ -0,0 +1,97 @@
+package bst
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+)
+
+// Errors returned when building a Bst from bulk input
+var (
+	ErrLengthMismatch error = errors.New("keys and values must have the same length")
+	ErrUnsortedKeys   error = errors.New("keys must be sorted in ascending order")
+	ErrDuplicateKey   error = errors.New("keys must not contain duplicates")
+)
+
+// BuildFromSorted constructs a perfectly balanced Bst, ordered by the natural ordering of its keys,
+// from keys sorted in strictly ascending order and their corresponding values
+func BuildFromSorted[K cmp.Ordered, V any](keys []K, vals []V, opts ...Option) (*Bst[K, V], error) {
+	return BuildFromSortedFunc(keys, vals, cmp.Compare[K], opts...)
+}
+
+// BuildFromSortedFunc constructs a perfectly balanced Bst, ordered by a comparison function, from
+// keys sorted in strictly ascending order of the comparison function and their corresponding values
+func BuildFromSortedFunc[K any, V any](keys []K, vals []V, cmp func(a, b K) int, opts ...Option) (*Bst[K, V], error) {
+	if len(keys) != len(vals) {
+		return nil, ErrLengthMismatch
+	}
+	for i := 1; i < len(keys); i++ {
+		c := cmp(keys[i-1], keys[i])
+		if c == 0 {
+			return nil, ErrDuplicateKey
+		}
+		if c > 0 {
+			return nil, ErrUnsortedKeys
+		}
+	}
+	return NewBstFunc(buildSorted(keys, vals), cmp, opts...), nil
+}
+
+// FromMap constructs a perfectly balanced Bst, ordered by the natural ordering of its keys, from the
+// key/value pairs of a map
+func FromMap[K cmp.Ordered, V any](m map[K]V, opts ...Option) (*Bst[K, V], error) {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	vals := make([]V, 0, len(keys))
+	for _, key := range keys {
+		vals = append(vals, m[key])
+	}
+	return BuildFromSorted(keys, vals, opts...)
+}
+
+// FromSlice constructs a perfectly balanced Bst, ordered by the natural ordering of its keys, from
+// unsorted keys and their corresponding values
+func FromSlice[K cmp.Ordered, V any](keys []K, vals []V, opts ...Option) (*Bst[K, V], error) {
+	return FromSliceFunc(keys, vals, cmp.Compare[K], opts...)
+}
+
+// FromSliceFunc constructs a perfectly balanced Bst, ordered by a comparison function, from unsorted
+// keys and their corresponding values
+func FromSliceFunc[K any, V any](keys []K, vals []V, cmp func(a, b K) int, opts ...Option) (*Bst[K, V], error) {
+	if len(keys) != len(vals) {
+		return nil, ErrLengthMismatch
+	}
+
+	// sort positions rather than keys so that each value stays paired with its key
+	order := make([]int, len(keys))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortFunc(order, func(i, j int) int {
+		return cmp(keys[i], keys[j])
+	})
+
+	sortedKeys := make([]K, len(keys))
+	sortedVals := make([]V, len(vals))
+	for i, pos := range order {
+		sortedKeys[i], sortedVals[i] = keys[pos], vals[pos]
+	}
+	return BuildFromSortedFunc(sortedKeys, sortedVals, cmp, opts...)
+}
+
+// buildSorted links sorted keys and their values into a perfectly balanced tree and returns its root
+func buildSorted[K any, V any](keys []K, vals []V) *Node[K, V] {
+	if len(keys) == 0 {
+		return nil
+	}
+	mid := len(keys) / 2
+	return NewNode(
+		keys[mid], vals[mid],
+		buildSorted(keys[:mid], vals[:mid]),
+		buildSorted(keys[mid+1:], vals[mid+1:]),
+	)
+}
@@ -0,0 +1,192 @@
+package bst
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildFromSorted(t *testing.T) {
+	tests := map[string]struct {
+		keys         []int
+		vals         []string
+		expectedTree *Bst[int, string]
+		expectedErr  error
+	}{
+		"empty input": {
+			keys:         []int{},
+			vals:         []string{},
+			expectedTree: NewBst[int, string](nil),
+		},
+		"single key": {
+			keys:         []int{10},
+			vals:         []string{"val10"},
+			expectedTree: NewBst(NewNode(10, "val10", nil, nil)),
+		},
+		"odd number of keys": {
+			keys: []int{10, 15, 20, 25, 30},
+			vals: []string{"val10", "val15", "val20", "val25", "val30"},
+			expectedTree: NewBst(
+				NewNode(20, "val20",
+					NewNode(15, "val15",
+						NewNode(10, "val10", nil, nil),
+						nil,
+					),
+					NewNode(30, "val30",
+						NewNode(25, "val25", nil, nil),
+						nil,
+					),
+				),
+			),
+		},
+		"even number of keys": {
+			keys: []int{10, 15, 20, 25},
+			vals: []string{"val10", "val15", "val20", "val25"},
+			expectedTree: NewBst(
+				NewNode(20, "val20",
+					NewNode(15, "val15",
+						NewNode(10, "val10", nil, nil),
+						nil,
+					),
+					NewNode(25, "val25", nil, nil),
+				),
+			),
+		},
+		"length mismatch": {
+			keys:        []int{10, 15},
+			vals:        []string{"val10"},
+			expectedErr: ErrLengthMismatch,
+		},
+		"unsorted keys": {
+			keys:        []int{10, 20, 15},
+			vals:        []string{"val10", "val20", "val15"},
+			expectedErr: ErrUnsortedKeys,
+		},
+		"duplicate keys": {
+			keys:        []int{10, 15, 15},
+			vals:        []string{"val10", "val15", "val15"},
+			expectedErr: ErrDuplicateKey,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree, err := BuildFromSorted(test.keys, test.vals)
+			a.Equal(test.expectedErr, err)
+			if test.expectedErr != nil {
+				return
+			}
+			assertBstEqual(t, test.expectedTree, tree)
+			a.Equal(len(test.keys), tree.Len())
+			if tree.IsEmpty() {
+				return
+			}
+
+			valid, err := tree.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestBuildFromSortedLarge(t *testing.T) {
+	a := assert.New(t)
+	n := 1000
+	keys := make([]int, n)
+	vals := make([]string, n)
+	for i := range keys {
+		keys[i], vals[i] = i, valFor(i)
+	}
+
+	tree, err := BuildFromSorted(keys, vals)
+	a.NoError(err)
+	valid, err := tree.Validate()
+	a.NoError(err)
+	a.True(valid)
+	a.Equal(n, tree.Len())
+
+	// a perfectly balanced tree of 1000 nodes has 10 levels
+	maxDepth := 0
+	var walk func(n *Node[int, string], depth int)
+	walk = func(n *Node[int, string], depth int) {
+		if n == nil {
+			return
+		}
+		maxDepth = max(maxDepth, depth)
+		walk(n.Left, depth+1)
+		walk(n.Right, depth+1)
+	}
+	walk(tree.Tree, 1)
+	a.Equal(10, maxDepth)
+}
+
+func TestFromSlice(t *testing.T) {
+	tests := map[string]struct {
+		keys         []int
+		vals         []string
+		expectedKeys []int
+		expectedErr  error
+	}{
+		"empty input": {
+			keys:         []int{},
+			vals:         []string{},
+			expectedKeys: []int{},
+		},
+		"unsorted keys": {
+			keys:         []int{30, 10, 25, 15, 20},
+			vals:         []string{"val30", "val10", "val25", "val15", "val20"},
+			expectedKeys: []int{10, 15, 20, 25, 30},
+		},
+		"length mismatch": {
+			keys:        []int{10, 15},
+			vals:        []string{"val10"},
+			expectedErr: ErrLengthMismatch,
+		},
+		"duplicate keys": {
+			keys:        []int{15, 10, 15},
+			vals:        []string{"val15", "val10", "val15"},
+			expectedErr: ErrDuplicateKey,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree, err := FromSlice(test.keys, test.vals)
+			a.Equal(test.expectedErr, err)
+			if test.expectedErr != nil {
+				return
+			}
+			keys := []int{}
+			for key, val := range tree.All() {
+				a.Equal(valFor(key), val)
+				keys = append(keys, key)
+			}
+			a.Equal(test.expectedKeys, keys)
+		})
+	}
+}
+
+func TestFromMap(t *testing.T) {
+	a := assert.New(t)
+	m := map[int]string{}
+	for _, key := range rand.New(rand.NewSource(1)).Perm(100) {
+		m[key] = valFor(key)
+	}
+
+	tree, err := FromMap(m, WithDeleteStrategy(DeleteLeft))
+	a.NoError(err)
+	a.Equal(DeleteLeft, tree.DeleteStrategy())
+	a.Equal(len(m), tree.Len())
+
+	valid, err := tree.Validate()
+	a.NoError(err)
+	a.True(valid)
+	for key, val := range m {
+		found, ok := tree.Search(key)
+		a.True(ok)
+		a.Equal(val, found)
+	}
+}
@@ -0,0 +1,132 @@
+package bst
+
+import "github.com/dkaslovsky/search-structures/stack"
+
+// Cursor is a position within a Bst that can be moved forward and backward in key order; a Cursor
+// is invalidated by any modification of the tree
+type Cursor[K any, V any] struct {
+	// path holds the ancestors of the current node from the root down, with the current node on top
+	path *stack.Stack[*Node[K, V]]
+}
+
+// First returns a Cursor positioned at the node with the smallest key, for iterating forward
+func (b *Bst[K, V]) First() *Cursor[K, V] {
+	c := &Cursor[K, V]{path: stack.NewStack[*Node[K, V]]()}
+	c.pushPath(b.Tree, true)
+	return c
+}
+
+// Last returns a Cursor positioned at the node with the largest key, for iterating in reverse
+func (b *Bst[K, V]) Last() *Cursor[K, V] {
+	c := &Cursor[K, V]{path: stack.NewStack[*Node[K, V]]()}
+	c.pushPath(b.Tree, false)
+	return c
+}
+
+// Seek returns a Cursor positioned at the node with the smallest key greater than or equal to key
+func (b *Bst[K, V]) Seek(key K) *Cursor[K, V] {
+	c := &Cursor[K, V]{path: stack.NewStack[*Node[K, V]]()}
+
+	// the target is the last node on the search path at which the search moved left or stopped
+	var target *Node[K, V]
+	curTree := b.Tree
+	for curTree != nil {
+		c.path.Push(curTree)
+		order := b.cmp(key, curTree.Key)
+		if order == 0 {
+			return c
+		}
+		if order < 0 {
+			target = curTree
+			curTree = curTree.Left
+			continue
+		}
+		curTree = curTree.Right
+	}
+
+	if target == nil {
+		// every key is less than key
+		c.path.Clear()
+		return c
+	}
+	for top, _ := c.path.Peek(); top != target; top, _ = c.path.Peek() {
+		c.path.Pop()
+	}
+	return c
+}
+
+// Valid evaluates if a Cursor is positioned at a node
+func (c *Cursor[K, V]) Valid() bool {
+	return !c.path.IsEmpty()
+}
+
+// Key returns the key of the node at a Cursor's position, which must be valid
+func (c *Cursor[K, V]) Key() K {
+	n, _ := c.path.Peek()
+	return n.Key
+}
+
+// Val returns the value of the node at a Cursor's position, which must be valid
+func (c *Cursor[K, V]) Val() V {
+	n, _ := c.path.Peek()
+	return n.Val
+}
+
+// Next moves a Cursor to the node with the next larger key, invalidating it after the last node
+func (c *Cursor[K, V]) Next() {
+	c.step(true)
+}
+
+// Prev moves a Cursor to the node with the next smaller key, invalidating it before the first node
+func (c *Cursor[K, V]) Prev() {
+	c.step(false)
+}
+
+// step moves a Cursor to the adjacent node in ascending key order when forward and in descending key
+// order otherwise
+func (c *Cursor[K, V]) step(forward bool) {
+	curNode, err := c.path.Peek()
+	if err == stack.ErrEmptyStack {
+		return
+	}
+
+	next := curNode.Right
+	if !forward {
+		next = curNode.Left
+	}
+	if next != nil {
+		// the adjacent node is the nearest node of the subtree on the side being moved toward
+		c.pushPath(next, forward)
+		return
+	}
+
+	// otherwise the adjacent node is the nearest ancestor reached from the side opposite the move
+	child, _ := c.path.Pop()
+	for {
+		parent, err := c.path.Peek()
+		if err == stack.ErrEmptyStack {
+			return
+		}
+		if nextChild(parent, !forward) == child {
+			return
+		}
+		child, _ = c.path.Pop()
+	}
+}
+
+// pushPath pushes n and its chain of left descendants when leftmost, or its chain of right
+// descendants otherwise
+func (c *Cursor[K, V]) pushPath(n *Node[K, V], leftmost bool) {
+	for n != nil {
+		c.path.Push(n)
+		n = nextChild(n, !leftmost)
+	}
+}
+
+// nextChild returns the right child of n when right and the left child otherwise
+func nextChild[K any, V any](n *Node[K, V], right bool) *Node[K, V] {
+	if right {
+		return n.Right
+	}
+	return n.Left
+}
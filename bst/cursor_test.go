@@ -0,0 +1,188 @@
+package bst
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newCursorTestTree() *Bst[int, string] {
+	return NewBst(
+		NewNode(20, "val20",
+			NewNode(10, "val10",
+				nil,
+				NewNode(15, "val15", nil, nil),
+			),
+			NewNode(30, "val30",
+				NewNode(25, "val25", nil, nil),
+				NewNode(40, "val40",
+					NewNode(32, "val32", nil,
+						NewNode(34, "val34", nil, nil),
+					),
+					NewNode(42, "val42", nil, nil),
+				),
+			),
+		),
+	)
+}
+
+func TestCursorForward(t *testing.T) {
+	tests := map[string]struct {
+		tree         *Bst[int, string]
+		expectedKeys []int
+	}{
+		"empty tree": {
+			tree:         NewBst[int, string](nil),
+			expectedKeys: []int{},
+		},
+		"single node tree": {
+			tree:         NewBst(NewNode(10, "val10", nil, nil)),
+			expectedKeys: []int{10},
+		},
+		"deep multi node tree": {
+			tree:         newCursorTestTree(),
+			expectedKeys: []int{10, 15, 20, 25, 30, 32, 34, 40, 42},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			keys := []int{}
+			for c := test.tree.First(); c.Valid(); c.Next() {
+				a.Equal(valFor(c.Key()), c.Val())
+				keys = append(keys, c.Key())
+			}
+			a.Equal(test.expectedKeys, keys)
+		})
+	}
+}
+
+func TestCursorReverse(t *testing.T) {
+	tests := map[string]struct {
+		tree         *Bst[int, string]
+		expectedKeys []int
+	}{
+		"empty tree": {
+			tree:         NewBst[int, string](nil),
+			expectedKeys: []int{},
+		},
+		"single node tree": {
+			tree:         NewBst(NewNode(10, "val10", nil, nil)),
+			expectedKeys: []int{10},
+		},
+		"deep multi node tree": {
+			tree:         newCursorTestTree(),
+			expectedKeys: []int{42, 40, 34, 32, 30, 25, 20, 15, 10},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			keys := []int{}
+			for c := test.tree.Last(); c.Valid(); c.Prev() {
+				a.Equal(valFor(c.Key()), c.Val())
+				keys = append(keys, c.Key())
+			}
+			a.Equal(test.expectedKeys, keys)
+		})
+	}
+}
+
+func TestCursorSeek(t *testing.T) {
+	tests := map[string]struct {
+		seekKey       int
+		expectedValid bool
+		expectedKey   int
+	}{
+		"seek existing key": {
+			seekKey:       32,
+			expectedValid: true,
+			expectedKey:   32,
+		},
+		"seek between keys in left subtree": {
+			seekKey:       31,
+			expectedValid: true,
+			expectedKey:   32,
+		},
+		"seek between keys resolving to ancestor": {
+			seekKey:       35,
+			expectedValid: true,
+			expectedKey:   40,
+		},
+		"seek below all keys": {
+			seekKey:       0,
+			expectedValid: true,
+			expectedKey:   10,
+		},
+		"seek above all keys": {
+			seekKey:       100,
+			expectedValid: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			c := newCursorTestTree().Seek(test.seekKey)
+			a.Equal(test.expectedValid, c.Valid())
+			if !test.expectedValid {
+				return
+			}
+			a.Equal(test.expectedKey, c.Key())
+		})
+	}
+
+	t.Run("empty tree", func(t *testing.T) {
+		assert.False(t, NewBst[int, string](nil).Seek(10).Valid())
+	})
+}
+
+func TestCursorChangeDirection(t *testing.T) {
+	a := assert.New(t)
+	c := newCursorTestTree().Seek(33)
+	a.Equal(34, c.Key())
+	c.Next()
+	c.Next()
+	a.Equal(42, c.Key())
+	c.Prev()
+	c.Prev()
+	c.Prev()
+	a.Equal(32, c.Key())
+	c.Prev()
+	c.Prev()
+	c.Prev()
+	c.Prev()
+	a.Equal(15, c.Key())
+	c.Prev()
+	c.Prev()
+	a.False(c.Valid())
+}
+
+func TestCursorSeekRandom(t *testing.T) {
+	a := assert.New(t)
+	r := rand.New(rand.NewSource(1))
+	tree := NewBst[int, string](nil)
+	for _, key := range r.Perm(100) {
+		tree.Insert(2*key, valFor(2*key))
+	}
+
+	for seekKey := -1; seekKey < 200; seekKey++ {
+		c := tree.Seek(seekKey)
+		expectedKey, _, err := tree.Ceiling(seekKey)
+		if err != nil {
+			a.False(c.Valid())
+			continue
+		}
+		a.Equal(expectedKey, c.Key())
+
+		// walking forward from the seek position visits every remaining key in order
+		count := 0
+		for ; c.Valid(); c.Next() {
+			count++
+		}
+		a.Equal(tree.Len()-tree.Rank(seekKey), count)
+	}
+}
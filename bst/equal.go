@@ -0,0 +1,100 @@
+package bst
+
+import "github.com/dkaslovsky/search-structures/queue"
+
+// Difference reports the keys, in ascending order, at which the contents of two Bsts differ
+type Difference[K any] struct {
+	// Added holds the keys found only in the second Bst
+	Added []K
+	// Removed holds the keys found only in the first Bst
+	Removed []K
+	// Changed holds the keys found in both Bsts with unequal values
+	Changed []K
+}
+
+// Equal evaluates if two Bsts have the same shape with equal keys and values at every position
+func Equal[K any, V comparable](b1 *Bst[K, V], b2 *Bst[K, V]) bool {
+	return EqualFunc(b1, b2, func(v1, v2 V) bool {
+		return v1 == v2
+	})
+}
+
+// EqualFunc evaluates if two Bsts have the same shape with equal keys at every position and values
+// that are equal according to a comparison function
+func EqualFunc[K any, V any](b1 *Bst[K, V], b2 *Bst[K, V], eq func(v1, v2 V) bool) bool {
+	// pairs hold the nodes at the same position of each tree
+	type pair struct {
+		n1, n2 *Node[K, V]
+	}
+
+	q := queue.NewQueue[pair]()
+	q.Push(pair{b1.Tree, b2.Tree})
+	for p := range q.Drain() {
+		if p.n1 == nil || p.n2 == nil {
+			if p.n1 != p.n2 {
+				return false
+			}
+			continue
+		}
+		if b1.cmp(p.n1.Key, p.n2.Key) != 0 || !eq(p.n1.Val, p.n2.Val) {
+			return false
+		}
+		q.Push(pair{p.n1.Left, p.n2.Left})
+		q.Push(pair{p.n1.Right, p.n2.Right})
+	}
+	return true
+}
+
+// EqualContents evaluates if two Bsts hold equal key/value pairs regardless of their shapes
+func EqualContents[K any, V comparable](b1 *Bst[K, V], b2 *Bst[K, V]) bool {
+	if b1.Len() != b2.Len() {
+		return false
+	}
+
+	c1, c2 := b1.First(), b2.First()
+	for ; c1.Valid(); c1.Next() {
+		if b1.cmp(c1.Key(), c2.Key()) != 0 || c1.Val() != c2.Val() {
+			return false
+		}
+		c2.Next()
+	}
+	return true
+}
+
+// Diff reports the keys that were added, removed or changed in going from the contents of b1 to the
+// contents of b2
+func Diff[K any, V comparable](b1 *Bst[K, V], b2 *Bst[K, V]) Difference[K] {
+	d := Difference[K]{
+		Added:   []K{},
+		Removed: []K{},
+		Changed: []K{},
+	}
+
+	// walk both trees in lockstep, advancing whichever cursor is at the smaller key
+	c1, c2 := b1.First(), b2.First()
+	for c1.Valid() && c2.Valid() {
+		c := b1.cmp(c1.Key(), c2.Key())
+		if c < 0 {
+			d.Removed = append(d.Removed, c1.Key())
+			c1.Next()
+			continue
+		}
+		if c > 0 {
+			d.Added = append(d.Added, c2.Key())
+			c2.Next()
+			continue
+		}
+		if c1.Val() != c2.Val() {
+			d.Changed = append(d.Changed, c1.Key())
+		}
+		c1.Next()
+		c2.Next()
+	}
+	for ; c1.Valid(); c1.Next() {
+		d.Removed = append(d.Removed, c1.Key())
+	}
+	for ; c2.Valid(); c2.Next() {
+		d.Added = append(d.Added, c2.Key())
+	}
+	return d
+}
@@ -0,0 +1,185 @@
+package bst
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqualFunc(t *testing.T) {
+	a := assert.New(t)
+	tree1 := NewBst(
+		NewNode(20, "val20",
+			NewNode(10, "val10", nil, nil),
+			NewNode(30, "val30", nil, nil),
+		),
+	)
+	tree2 := NewBst(
+		NewNode(20, "VAL20",
+			NewNode(10, "VAL10", nil, nil),
+			NewNode(30, "VAL30", nil, nil),
+		),
+	)
+
+	a.False(Equal(tree1, tree2))
+	a.True(EqualFunc(tree1, tree2, strings.EqualFold))
+}
+
+func TestEqualContents(t *testing.T) {
+	tests := map[string]struct {
+		tree1      *Bst[int, string]
+		tree2      *Bst[int, string]
+		expectedEq bool
+	}{
+		"empty trees": {
+			tree1:      NewBst[int, string](nil),
+			tree2:      NewBst[int, string](nil),
+			expectedEq: true,
+		},
+		"empty and nonempty trees": {
+			tree1:      NewBst[int, string](nil),
+			tree2:      NewBst(NewNode(10, "val10", nil, nil)),
+			expectedEq: false,
+		},
+		"same contents in different shapes": {
+			tree1: NewBst(
+				NewNode(20, "val20",
+					NewNode(10, "val10", nil, nil),
+					NewNode(30, "val30", nil, nil),
+				),
+			),
+			tree2: NewBst(
+				NewNode(10, "val10",
+					nil,
+					NewNode(20, "val20",
+						nil,
+						NewNode(30, "val30", nil, nil),
+					),
+				),
+			),
+			expectedEq: true,
+		},
+		"value mismatch": {
+			tree1: NewBst(
+				NewNode(20, "val20",
+					NewNode(10, "val10", nil, nil),
+					nil,
+				),
+			),
+			tree2: NewBst(
+				NewNode(10, "val10",
+					nil,
+					NewNode(20, "newVal20", nil, nil),
+				),
+			),
+			expectedEq: false,
+		},
+		"key mismatch with equal sizes": {
+			tree1: NewBst(
+				NewNode(20, "val20",
+					NewNode(10, "val10", nil, nil),
+					nil,
+				),
+			),
+			tree2: NewBst(
+				NewNode(20, "val20",
+					NewNode(15, "val10", nil, nil),
+					nil,
+				),
+			),
+			expectedEq: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			a.Equal(test.expectedEq, EqualContents(test.tree1, test.tree2))
+			a.Equal(test.expectedEq, EqualContents(test.tree2, test.tree1))
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := map[string]struct {
+		tree1        *Bst[int, string]
+		tree2        *Bst[int, string]
+		expectedDiff Difference[int]
+	}{
+		"empty trees": {
+			tree1: NewBst[int, string](nil),
+			tree2: NewBst[int, string](nil),
+			expectedDiff: Difference[int]{
+				Added:   []int{},
+				Removed: []int{},
+				Changed: []int{},
+			},
+		},
+		"all keys added": {
+			tree1: NewBst[int, string](nil),
+			tree2: NewBst(
+				NewNode(20, "val20",
+					NewNode(10, "val10", nil, nil),
+					nil,
+				),
+			),
+			expectedDiff: Difference[int]{
+				Added:   []int{10, 20},
+				Removed: []int{},
+				Changed: []int{},
+			},
+		},
+		"all keys removed": {
+			tree1: NewBst(
+				NewNode(20, "val20",
+					NewNode(10, "val10", nil, nil),
+					nil,
+				),
+			),
+			tree2: NewBst[int, string](nil),
+			expectedDiff: Difference[int]{
+				Added:   []int{},
+				Removed: []int{10, 20},
+				Changed: []int{},
+			},
+		},
+		"added, removed and changed keys": {
+			tree1: NewBst(
+				NewNode(20, "val20",
+					NewNode(10, "val10",
+						nil,
+						NewNode(15, "val15", nil, nil),
+					),
+					NewNode(30, "val30",
+						NewNode(25, "val25", nil, nil),
+						NewNode(40, "val40", nil, nil),
+					),
+				),
+			),
+			tree2: NewBst(
+				NewNode(25, "val25",
+					NewNode(12, "val12",
+						NewNode(10, "val10", nil, nil),
+						NewNode(20, "newVal20", nil, nil),
+					),
+					NewNode(40, "newVal40",
+						NewNode(30, "val30", nil, nil),
+						NewNode(50, "val50", nil, nil),
+					),
+				),
+			),
+			expectedDiff: Difference[int]{
+				Added:   []int{12, 50},
+				Removed: []int{15},
+				Changed: []int{20, 40},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expectedDiff, Diff(test.tree1, test.tree2))
+		})
+	}
+}
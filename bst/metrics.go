@@ -0,0 +1,117 @@
+package bst
+
+import "github.com/dkaslovsky/search-structures/queue"
+
+// The depth of a node is the number of edges on the path to it from the root, so the root has depth
+// zero and the Height of a Bst is one more than the depth of its deepest node
+
+// NodeBalance reports the shape of the subtree rooted at the node indexed by Key
+type NodeBalance[K any] struct {
+	Key K
+	// Height is the number of levels of the subtree
+	Height int
+	// BalanceFactor is the height of the left subtree minus the height of the right subtree
+	BalanceFactor int
+}
+
+// LevelHistogram returns the number of nodes at each depth of a Bst, indexed by depth
+func (b *Bst[K, V]) LevelHistogram() []int {
+	histogram := []int{}
+	b.walkLevels(func(depth int, _ *Node[K, V]) bool {
+		if depth == len(histogram) {
+			histogram = append(histogram, 0)
+		}
+		histogram[depth]++
+		return true
+	})
+	return histogram
+}
+
+// MinLeafDepth returns the depth of the shallowest leaf of a Bst
+func (b *Bst[K, V]) MinLeafDepth() (int, error) {
+	if b.IsEmpty() {
+		return 0, ErrEmpty
+	}
+
+	minDepth := 0
+	b.walkLevels(func(depth int, n *Node[K, V]) bool {
+		if n.Left == nil && n.Right == nil {
+			minDepth = depth
+			return false
+		}
+		return true
+	})
+	return minDepth, nil
+}
+
+// AverageDepth returns the mean depth of the nodes of a Bst, which is proportional to the expected
+// cost of a successful search
+func (b *Bst[K, V]) AverageDepth() (float64, error) {
+	if b.IsEmpty() {
+		return 0, ErrEmpty
+	}
+
+	total := 0
+	for depth, count := range b.LevelHistogram() {
+		total += depth * count
+	}
+	return float64(total) / float64(b.Len()), nil
+}
+
+// BalanceReport returns the height and balance factor of the subtree rooted at each node of a Bst, in
+// ascending key order
+func (b *Bst[K, V]) BalanceReport() []NodeBalance[K] {
+	// heights are computed children first so that each node's height is available to its parent
+	heights := map[*Node[K, V]]int{}
+	height := func(n *Node[K, V]) int {
+		if n == nil {
+			return 0
+		}
+		return heights[n]
+	}
+	next := b.PostOrder()
+	for {
+		curB, err := next()
+		if err == ErrIteratorStop {
+			break
+		}
+		heights[curB] = 1 + max(height(curB.Left), height(curB.Right))
+	}
+
+	report := []NodeBalance[K]{}
+	for n := range inOrderSeq(b.Tree, false) {
+		report = append(report, NodeBalance[K]{
+			Key:           n.Key,
+			Height:        heights[n],
+			BalanceFactor: height(n.Left) - height(n.Right),
+		})
+	}
+	return report
+}
+
+// walkLevels visits the nodes of a Bst in breadth-first order along with their depths until visit
+// returns false
+func (b *Bst[K, V]) walkLevels(visit func(depth int, n *Node[K, V]) bool) {
+	if b.IsEmpty() {
+		return
+	}
+
+	q := queue.NewQueue[*Node[K, V]]()
+	q.Push(b.Tree)
+	for depth := 0; !q.IsEmpty(); depth++ {
+		// the queue holds exactly the nodes of the current depth at the start of each iteration
+		for range q.Len() {
+			curB, _ := q.Pop()
+			if !visit(depth, curB) {
+				q.Clear()
+				return
+			}
+			if curB.Left != nil {
+				q.Push(curB.Left)
+			}
+			if curB.Right != nil {
+				q.Push(curB.Right)
+			}
+		}
+	}
+}
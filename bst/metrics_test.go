@@ -0,0 +1,85 @@
+package bst
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	tests := map[string]struct {
+		tree                  *Bst[int, string]
+		expectedHistogram     []int
+		expectedMinLeafDepth  int
+		expectedAverageDepth  float64
+		expectedBalanceReport []NodeBalance[int]
+		expectedErr           error
+	}{
+		"empty tree": {
+			tree:                  NewBst[int, string](nil),
+			expectedHistogram:     []int{},
+			expectedBalanceReport: []NodeBalance[int]{},
+			expectedErr:           ErrEmpty,
+		},
+		"single node tree": {
+			tree:                 NewBst(NewNode(10, "val10", nil, nil)),
+			expectedHistogram:    []int{1},
+			expectedMinLeafDepth: 0,
+			expectedAverageDepth: 0,
+			expectedBalanceReport: []NodeBalance[int]{
+				{Key: 10, Height: 1, BalanceFactor: 0},
+			},
+		},
+		"deep multi node tree": {
+			tree: NewBst(
+				NewNode(20, "val20",
+					NewNode(10, "val10",
+						nil,
+						NewNode(15, "val15", nil, nil),
+					),
+					NewNode(30, "val30",
+						NewNode(25, "val25", nil, nil),
+						NewNode(40, "val40",
+							NewNode(32, "val32", nil,
+								NewNode(34, "val34", nil, nil),
+							),
+							nil,
+						),
+					),
+				),
+			),
+			expectedHistogram:    []int{1, 2, 3, 1, 1},
+			expectedMinLeafDepth: 2,
+			expectedAverageDepth: 15.0 / 8.0,
+			expectedBalanceReport: []NodeBalance[int]{
+				{Key: 10, Height: 2, BalanceFactor: -1},
+				{Key: 15, Height: 1, BalanceFactor: 0},
+				{Key: 20, Height: 5, BalanceFactor: -2},
+				{Key: 25, Height: 1, BalanceFactor: 0},
+				{Key: 30, Height: 4, BalanceFactor: -2},
+				{Key: 32, Height: 2, BalanceFactor: -1},
+				{Key: 34, Height: 1, BalanceFactor: 0},
+				{Key: 40, Height: 3, BalanceFactor: 2},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			a.Equal(test.expectedHistogram, test.tree.LevelHistogram())
+			a.Equal(len(test.expectedHistogram), test.tree.Height())
+			a.Equal(test.expectedBalanceReport, test.tree.BalanceReport())
+
+			minLeafDepth, err := test.tree.MinLeafDepth()
+			a.Equal(test.expectedErr, err)
+			averageDepth, err := test.tree.AverageDepth()
+			a.Equal(test.expectedErr, err)
+			if test.expectedErr != nil {
+				return
+			}
+			a.Equal(test.expectedMinLeafDepth, minLeafDepth)
+			a.InDelta(test.expectedAverageDepth, averageDepth, 1e-9)
+		})
+	}
+}
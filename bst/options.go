@@ -0,0 +1,85 @@
+package bst
+
+import "math/rand"
+
+// DeleteStrategy determines which side of a node with two children supplies the key/value that
+// replaces it when the node is deleted
+type DeleteStrategy uint
+
+const (
+	// DeleteRandom chooses the side at random on each delete to avoid creating an unbalanced tree
+	DeleteRandom DeleteStrategy = iota
+	// DeleteLeft always replaces with the rightmost (max) key/value from the left branch
+	DeleteLeft
+	// DeleteRight always replaces with the leftmost (min) key/value from the right branch
+	DeleteRight
+	// DeleteAlternating switches sides on each call to Delete, starting with the left
+	DeleteAlternating
+)
+
+// String returns the name of a DeleteStrategy
+func (d DeleteStrategy) String() string {
+	switch d {
+	case DeleteRandom:
+		return "random"
+	case DeleteLeft:
+		return "left"
+	case DeleteRight:
+		return "right"
+	case DeleteAlternating:
+		return "alternating"
+	default:
+		return "unknown"
+	}
+}
+
+// Option configures a Bst at construction
+type Option func(*options)
+
+type options struct {
+	r               *rand.Rand
+	strategy        DeleteStrategy
+	rebalanceFactor float64
+	// newRand constructs a source of randomness for copies of a Bst, or is nil if copies should be
+	// seeded from the current time
+	newRand func() *rand.Rand
+}
+
+// WithSeed seeds the source of randomness used by a Bst, making random choices reproducible; clones
+// and snapshots of the Bst are seeded with the same seed
+func WithSeed(seed int64) Option {
+	return func(o *options) {
+		o.newRand = func() *rand.Rand {
+			return rand.New(rand.NewSource(seed))
+		}
+		o.r = o.newRand()
+	}
+}
+
+// WithRand sets the source of randomness used by a Bst; since a rand.Rand cannot be copied, clones
+// and snapshots of the Bst are seeded from the current time
+func WithRand(r *rand.Rand) Option {
+	return func(o *options) {
+		o.r = r
+		o.newRand = nil
+	}
+}
+
+// WithDeleteStrategy sets the DeleteStrategy used by a Bst, which is DeleteRandom by default; an
+// unknown strategy is ignored, leaving the strategy unchanged
+func WithDeleteStrategy(strategy DeleteStrategy) Option {
+	return func(o *options) {
+		if strategy > DeleteAlternating {
+			return
+		}
+		o.strategy = strategy
+	}
+}
+
+// WithAutoRebalance rebalances a Bst whenever an insert makes its height exceed c*log2(Len); since
+// a balanced tree already has height of at least log2(Len), c should be comfortably greater than one
+func WithAutoRebalance(c float64) Option {
+	return func(o *options) {
+		o.rebalanceFactor = c
+	}
+}
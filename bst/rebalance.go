@@ -0,0 +1,176 @@
+package bst
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Height returns the number of levels of a Bst
+func (b *Bst[K, V]) Height() int {
+	return len(b.LevelHistogram())
+}
+
+// Rebalance rearranges a Bst in place into a balanced tree of minimal height using the Day-Stout-Warren
+// algorithm, which runs in linear time with constant extra space
+func (b *Bst[K, V]) Rebalance() {
+	if b.IsEmpty() {
+		return
+	}
+
+	// a pseudo-root above the tree avoids special handling of rotations at the root
+	pseudoRoot := &Node[K, V]{Right: b.Tree}
+	n := b.treeToVine(pseudoRoot)
+	vineToTree(pseudoRoot, n)
+	b.Tree = pseudoRoot.Right
+}
+
+// RotateLeft rotates the subtree rooted at the node indexed by key to the left, so that the node's
+// right child takes its place and the node becomes that child's left child
+func (b *Bst[K, V]) RotateLeft(key K) error {
+	target, parent, err := b.rotationTarget(key)
+	if err != nil {
+		return err
+	}
+	if target.Right == nil {
+		return ErrInvalidRotation
+	}
+	target.Right = b.own(target.Right)
+	b.replaceChild(parent, target, target.rotateLeft())
+	return nil
+}
+
+// RotateRight rotates the subtree rooted at the node indexed by key to the right, so that the node's
+// left child takes its place and the node becomes that child's right child
+func (b *Bst[K, V]) RotateRight(key K) error {
+	target, parent, err := b.rotationTarget(key)
+	if err != nil {
+		return err
+	}
+	if target.Left == nil {
+		return ErrInvalidRotation
+	}
+	target.Left = b.own(target.Left)
+	b.replaceChild(parent, target, target.rotateRight())
+	return nil
+}
+
+// RotateLeftRight rotates the left child of the node indexed by key to the left and then the node to
+// the right, so that the right child of the node's left child takes its place
+func (b *Bst[K, V]) RotateLeftRight(key K) error {
+	target, parent, err := b.rotationTarget(key)
+	if err != nil {
+		return err
+	}
+	if target.Left == nil || target.Left.Right == nil {
+		return ErrInvalidRotation
+	}
+	target.Left = b.own(target.Left)
+	target.Left.Right = b.own(target.Left.Right)
+	target.Left = target.Left.rotateLeft()
+	b.replaceChild(parent, target, target.rotateRight())
+	return nil
+}
+
+// RotateRightLeft rotates the right child of the node indexed by key to the right and then the node
+// to the left, so that the left child of the node's right child takes its place
+func (b *Bst[K, V]) RotateRightLeft(key K) error {
+	target, parent, err := b.rotationTarget(key)
+	if err != nil {
+		return err
+	}
+	if target.Right == nil || target.Right.Left == nil {
+		return ErrInvalidRotation
+	}
+	target.Right = b.own(target.Right)
+	target.Right.Left = b.own(target.Right.Left)
+	target.Right = target.Right.rotateRight()
+	b.replaceChild(parent, target, target.rotateLeft())
+	return nil
+}
+
+// rotationTarget returns the node indexed by key and its parent, which is nil for the root, both
+// owned by the Bst so that they can be modified
+func (b *Bst[K, V]) rotationTarget(key K) (target *Node[K, V], parent *Node[K, V], err error) {
+	if b.IsEmpty() {
+		return nil, nil, ErrEmpty
+	}
+	target, _, found := b.search(key)
+	if !found {
+		return nil, nil, ErrKeyNotFound
+	}
+	target, parent = b.ownPath(target)
+	return target, parent, nil
+}
+
+// needsRebalance evaluates if a node inserted at the given level, counted from one at the root,
+// exceeds the height allowed by the auto-rebalance policy
+func (b *Bst[K, V]) needsRebalance(level int) bool {
+	if b.rebalanceFactor <= 0 {
+		return false
+	}
+	return float64(level) > b.rebalanceFactor*math.Log2(float64(b.Len()))
+}
+
+// treeToVine rotates the tree below pseudoRoot into a vine of right children in ascending key order
+// and returns its number of nodes; every node passes through the vine, so any nodes shared with a
+// snapshot are copied along the way
+func (b *Bst[K, V]) treeToVine(pseudoRoot *Node[K, V]) int {
+	n := 0
+	tail := pseudoRoot
+	rest := b.own(tail.Right)
+	tail.Right = rest
+	for rest != nil {
+		if rest.Left == nil {
+			n++
+			tail = rest
+			rest = b.own(rest.Right)
+			tail.Right = rest
+			continue
+		}
+		rest.Left = b.own(rest.Left)
+		rest = rest.rotateRight()
+		tail.Right = rest
+	}
+	return n
+}
+
+// vineToTree rotates the vine of n nodes below pseudoRoot into a balanced tree of minimal height
+func vineToTree[K any, V any](pseudoRoot *Node[K, V], n int) {
+	// the number of nodes in the largest perfect tree that fits within n nodes, with the remaining
+	// nodes forming a partial bottom level
+	m := 1<<(bits.Len(uint(n+1))-1) - 1
+	compress(pseudoRoot, n-m)
+	for m > 1 {
+		m /= 2
+		compress(pseudoRoot, m)
+	}
+}
+
+// compress performs count left rotations on every other node of the vine below pseudoRoot
+func compress[K any, V any](pseudoRoot *Node[K, V], count int) {
+	scanner := pseudoRoot
+	for range count {
+		scanner.Right = scanner.Right.rotateLeft()
+		scanner = scanner.Right
+	}
+}
+
+// rotateLeft rotates the subtree rooted at n to the left, maintaining sizes, and returns its new root
+func (n *Node[K, V]) rotateLeft() *Node[K, V] {
+	right := n.Right
+	n.Right = right.Left
+	right.Left = n
+	n.size = 1 + n.Left.Size() + n.Right.Size()
+	right.size = 1 + right.Left.Size() + right.Right.Size()
+	return right
+}
+
+// rotateRight rotates the subtree rooted at n to the right, maintaining sizes, and returns its new root
+func (n *Node[K, V]) rotateRight() *Node[K, V] {
+	left := n.Left
+	n.Left = left.Right
+	left.Right = n
+	n.size = 1 + n.Left.Size() + n.Right.Size()
+	left.size = 1 + left.Left.Size() + left.Right.Size()
+	return left
+}
@@ -0,0 +1,344 @@
+package bst
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeight(t *testing.T) {
+	tests := map[string]struct {
+		tree           *Bst[int, string]
+		expectedHeight int
+	}{
+		"empty tree": {
+			tree:           NewBst[int, string](nil),
+			expectedHeight: 0,
+		},
+		"single node tree": {
+			tree:           NewBst(NewNode(10, "val10", nil, nil)),
+			expectedHeight: 1,
+		},
+		"deep multi node tree": {
+			tree: NewBst(
+				NewNode(20, "val20",
+					NewNode(10, "val10",
+						nil,
+						NewNode(15, "val15", nil, nil),
+					),
+					NewNode(30, "val30",
+						NewNode(25, "val25", nil, nil),
+						NewNode(40, "val40",
+							NewNode(32, "val32", nil,
+								NewNode(34, "val34", nil, nil),
+							),
+							nil,
+						),
+					),
+				),
+			),
+			expectedHeight: 5,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expectedHeight, test.tree.Height())
+		})
+	}
+}
+
+func TestRebalance(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys     []int
+		expectedHeight int
+	}{
+		"empty tree": {
+			insertKeys:     []int{},
+			expectedHeight: 0,
+		},
+		"single node tree": {
+			insertKeys:     []int{10},
+			expectedHeight: 1,
+		},
+		"ascending chain": {
+			insertKeys:     []int{1, 2, 3, 4, 5, 6, 7},
+			expectedHeight: 3,
+		},
+		"descending chain": {
+			insertKeys:     []int{10, 9, 8, 7, 6, 5, 4, 3, 2, 1},
+			expectedHeight: 4,
+		},
+		"zigzag": {
+			insertKeys:     []int{1, 100, 2, 99, 3, 98, 4, 97},
+			expectedHeight: 4,
+		},
+		"random keys": {
+			insertKeys:     rand.New(rand.NewSource(1)).Perm(1000),
+			expectedHeight: 10,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := NewBst[int, string](nil)
+			for _, key := range test.insertKeys {
+				tree.Insert(key, valFor(key))
+			}
+			expectedKeys := iteratedKeys(tree.InOrder())
+
+			tree.Rebalance()
+			a.Equal(test.expectedHeight, tree.Height())
+			a.Equal(expectedKeys, iteratedKeys(tree.InOrder()))
+			a.Equal(len(test.insertKeys), tree.Len())
+			if tree.IsEmpty() {
+				return
+			}
+
+			valid, err := tree.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestAutoRebalance(t *testing.T) {
+	tests := map[string]struct {
+		opts       []Option
+		maxHeight  func(n int) int
+		degenerate bool
+	}{
+		"disabled": {
+			opts:       []Option{},
+			maxHeight:  func(n int) int { return n },
+			degenerate: true,
+		},
+		"factor of two": {
+			opts:      []Option{WithAutoRebalance(2)},
+			maxHeight: func(n int) int { return int(2 * math.Log2(float64(n))) },
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := NewBst[int, string](nil, test.opts...)
+			n := 500
+			for key := 0; key < n; key++ {
+				tree.Insert(key, valFor(key))
+				a.LessOrEqual(tree.Height(), max(test.maxHeight(tree.Len()), 1))
+			}
+
+			valid, err := tree.Validate()
+			a.NoError(err)
+			a.True(valid)
+			a.Equal(n, tree.Len())
+			a.Equal(test.degenerate, tree.Height() == n)
+		})
+	}
+}
+
+func TestRotate(t *testing.T) {
+	newTree := func() *Bst[int, string] {
+		return NewBst(
+			NewNode(20, "val20",
+				NewNode(10, "val10",
+					nil,
+					NewNode(15, "val15", nil, nil),
+				),
+				NewNode(30, "val30",
+					NewNode(25, "val25", nil, nil),
+					NewNode(40, "val40", nil, nil),
+				),
+			),
+		)
+	}
+
+	type rotation func(*Bst[int, string], int) error
+	rotateLeft := (*Bst[int, string]).RotateLeft
+	rotateRight := (*Bst[int, string]).RotateRight
+	rotateLeftRight := (*Bst[int, string]).RotateLeftRight
+	rotateRightLeft := (*Bst[int, string]).RotateRightLeft
+
+	tests := map[string]struct {
+		tree         *Bst[int, string]
+		rotate       rotation
+		key          int
+		expectedTree *Bst[int, string]
+		expectedErr  error
+	}{
+		"empty tree": {
+			tree:        NewBst[int, string](nil),
+			rotate:      rotateLeft,
+			key:         10,
+			expectedErr: ErrEmpty,
+		},
+		"key not in tree": {
+			tree:        newTree(),
+			rotate:      rotateLeft,
+			key:         11,
+			expectedErr: ErrKeyNotFound,
+		},
+		"left rotation of root": {
+			tree:   newTree(),
+			rotate: rotateLeft,
+			key:    20,
+			expectedTree: NewBst(
+				NewNode(30, "val30",
+					NewNode(20, "val20",
+						NewNode(10, "val10",
+							nil,
+							NewNode(15, "val15", nil, nil),
+						),
+						NewNode(25, "val25", nil, nil),
+					),
+					NewNode(40, "val40", nil, nil),
+				),
+			),
+		},
+		"right rotation of root": {
+			tree:   newTree(),
+			rotate: rotateRight,
+			key:    20,
+			expectedTree: NewBst(
+				NewNode(10, "val10",
+					nil,
+					NewNode(20, "val20",
+						NewNode(15, "val15", nil, nil),
+						NewNode(30, "val30",
+							NewNode(25, "val25", nil, nil),
+							NewNode(40, "val40", nil, nil),
+						),
+					),
+				),
+			),
+		},
+		"left rotation below root": {
+			tree:   newTree(),
+			rotate: rotateLeft,
+			key:    10,
+			expectedTree: NewBst(
+				NewNode(20, "val20",
+					NewNode(15, "val15",
+						NewNode(10, "val10", nil, nil),
+						nil,
+					),
+					NewNode(30, "val30",
+						NewNode(25, "val25", nil, nil),
+						NewNode(40, "val40", nil, nil),
+					),
+				),
+			),
+		},
+		"left rotation without right child": {
+			tree:        newTree(),
+			rotate:      rotateLeft,
+			key:         25,
+			expectedErr: ErrInvalidRotation,
+		},
+		"right rotation without left child": {
+			tree:        newTree(),
+			rotate:      rotateRight,
+			key:         10,
+			expectedErr: ErrInvalidRotation,
+		},
+		"left-right rotation of root": {
+			tree:   newTree(),
+			rotate: rotateLeftRight,
+			key:    20,
+			expectedTree: NewBst(
+				NewNode(15, "val15",
+					NewNode(10, "val10", nil, nil),
+					NewNode(20, "val20",
+						nil,
+						NewNode(30, "val30",
+							NewNode(25, "val25", nil, nil),
+							NewNode(40, "val40", nil, nil),
+						),
+					),
+				),
+			),
+		},
+		"left-right rotation without left grandchild": {
+			tree:        newTree(),
+			rotate:      rotateLeftRight,
+			key:         30,
+			expectedErr: ErrInvalidRotation,
+		},
+		"right-left rotation of root": {
+			tree:   newTree(),
+			rotate: rotateRightLeft,
+			key:    20,
+			expectedTree: NewBst(
+				NewNode(25, "val25",
+					NewNode(20, "val20",
+						NewNode(10, "val10",
+							nil,
+							NewNode(15, "val15", nil, nil),
+						),
+						nil,
+					),
+					NewNode(30, "val30",
+						nil,
+						NewNode(40, "val40", nil, nil),
+					),
+				),
+			),
+		},
+		"right-left rotation without right grandchild": {
+			tree:        newTree(),
+			rotate:      rotateRightLeft,
+			key:         10,
+			expectedErr: ErrInvalidRotation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			err := test.rotate(test.tree, test.key)
+			a.Equal(test.expectedErr, err)
+			if test.expectedErr != nil {
+				return
+			}
+			assertBstEqual(t, test.expectedTree, test.tree)
+
+			valid, err := test.tree.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestRotateRandomSequence(t *testing.T) {
+	a := assert.New(t)
+	r := rand.New(rand.NewSource(1))
+	tree := NewBst[int, string](nil)
+	for _, key := range r.Perm(100) {
+		tree.Insert(key, valFor(key))
+	}
+	expectedKeys := iteratedKeys(tree.InOrder())
+
+	rotations := []func(int) error{
+		tree.RotateLeft,
+		tree.RotateRight,
+		tree.RotateLeftRight,
+		tree.RotateRightLeft,
+	}
+	for i := 0; i < 1000; i++ {
+		err := rotations[r.Intn(len(rotations))](r.Intn(100))
+		if err != nil {
+			a.Equal(ErrInvalidRotation, err)
+			continue
+		}
+
+		valid, err := tree.Validate()
+		a.NoError(err)
+		a.True(valid)
+	}
+	a.Equal(expectedKeys, iteratedKeys(tree.InOrder()))
+	a.Equal(100, tree.Len())
+}
@@ -0,0 +1,110 @@
+package bst
+
+import (
+	"sync/atomic"
+
+	"github.com/dkaslovsky/search-structures/stack"
+)
+
+// generation is the source of unique generations for Bsts that share nodes
+var generation atomic.Uint64
+
+// Clone returns a deep copy of a Bst that shares no nodes with the original
+func (b *Bst[K, V]) Clone() *Bst[K, V] {
+	clone := b.copy()
+	if b.IsEmpty() {
+		return clone
+	}
+
+	// pairs hold a node of the original and its copy, whose children still point into the original
+	type pair struct {
+		orig, copy *Node[K, V]
+	}
+	cloneNode := func(n *Node[K, V]) *Node[K, V] {
+		c := *n
+		c.gen = clone.gen
+		return &c
+	}
+
+	clone.Tree = cloneNode(b.Tree)
+	s := stack.NewStack[pair]()
+	s.Push(pair{b.Tree, clone.Tree})
+	for {
+		p, err := s.Pop()
+		if err == stack.ErrEmptyStack {
+			return clone
+		}
+		if p.orig.Left != nil {
+			p.copy.Left = cloneNode(p.orig.Left)
+			s.Push(pair{p.orig.Left, p.copy.Left})
+		}
+		if p.orig.Right != nil {
+			p.copy.Right = cloneNode(p.orig.Right)
+			s.Push(pair{p.orig.Right, p.copy.Right})
+		}
+	}
+}
+
+// Snapshot returns a copy of a Bst in constant time that shares all nodes with the original; later
+// modifications of either copy the nodes along the modified paths, so neither is affected by changes
+// to the other and unchanged subtrees remain shared
+func (b *Bst[K, V]) Snapshot() *Bst[K, V] {
+	snapshot := b.copy()
+	snapshot.Tree = b.Tree
+	// the original no longer owns the shared nodes either
+	b.gen = generation.Add(1)
+	return snapshot
+}
+
+// copy returns an empty Bst with the configuration of b, a new generation and its own source of
+// randomness, leaving the source of b untouched
+func (b *Bst[K, V]) copy() *Bst[K, V] {
+	return &Bst[K, V]{
+		cmp:             b.cmp,
+		r:               b.newRand(),
+		newRand:         b.newRand,
+		strategy:        b.strategy,
+		rebalanceFactor: b.rebalanceFactor,
+		lastSide:        b.lastSide,
+		gen:             generation.Add(1),
+	}
+}
+
+// newNode constructs a leaf owned by the Bst
+func (b *Bst[K, V]) newNode(key K, val V) *Node[K, V] {
+	n := NewNode[K, V](key, val, nil, nil)
+	n.gen = b.gen
+	return n
+}
+
+// own returns n if it is owned by the Bst, and otherwise a copy of n owned by the Bst that can be
+// modified without affecting other Bsts sharing n
+func (b *Bst[K, V]) own(n *Node[K, V]) *Node[K, V] {
+	if n == nil || n.gen == b.gen {
+		return n
+	}
+	c := *n
+	c.gen = b.gen
+	return &c
+}
+
+// ownPath makes every node on the path from the root to target owned by the Bst, relinking each copy
+// into its parent, and returns the owned target and its parent, which is nil for the root
+func (b *Bst[K, V]) ownPath(target *Node[K, V]) (owned *Node[K, V], parent *Node[K, V]) {
+	b.Tree = b.own(b.Tree)
+	curTree := b.Tree
+	for {
+		c := b.cmp(target.Key, curTree.Key)
+		if c == 0 {
+			return curTree, parent
+		}
+		parent = curTree
+		if c < 0 {
+			curTree.Left = b.own(curTree.Left)
+			curTree = curTree.Left
+			continue
+		}
+		curTree.Right = b.own(curTree.Right)
+		curTree = curTree.Right
+	}
+}
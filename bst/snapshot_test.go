@@ -0,0 +1,272 @@
+package bst
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newSnapshotTestTree() *Bst[int, string] {
+	return NewBst(
+		NewNode(20, "val20",
+			NewNode(10, "val10",
+				nil,
+				NewNode(15, "val15", nil, nil),
+			),
+			NewNode(30, "val30",
+				NewNode(25, "val25", nil, nil),
+				NewNode(40, "val40", nil, nil),
+			),
+		),
+		WithSeed(1),
+	)
+}
+
+func nodeSet(tree *Bst[int, string]) map[*Node[int, string]]bool {
+	nodes := map[*Node[int, string]]bool{}
+	next := tree.Iterator()
+	for {
+		node, err := next()
+		if err == ErrIteratorStop {
+			return nodes
+		}
+		nodes[node] = true
+	}
+}
+
+func countShared(tree1 *Bst[int, string], tree2 *Bst[int, string]) int {
+	nodes := nodeSet(tree1)
+	shared := 0
+	for node := range nodeSet(tree2) {
+		if nodes[node] {
+			shared++
+		}
+	}
+	return shared
+}
+
+func TestClone(t *testing.T) {
+	t.Run("empty tree", func(t *testing.T) {
+		a := assert.New(t)
+		clone := NewBst[int, string](nil).Clone()
+		a.True(clone.IsEmpty())
+		clone.Insert(10, valFor(10))
+		a.Equal(1, clone.Len())
+	})
+
+	t.Run("multi node tree", func(t *testing.T) {
+		a := assert.New(t)
+		tree := newSnapshotTestTree()
+		clone := tree.Clone()
+		assertBstEqual(t, tree, clone)
+		a.Equal(0, countShared(tree, clone))
+
+		clone.Insert(12, valFor(12))
+		a.NoError(clone.Delete(30))
+		assertBstEqual(t, newSnapshotTestTree(), tree)
+
+		valid, err := clone.Validate()
+		a.NoError(err)
+		a.True(valid)
+	})
+}
+
+func TestCopyLeavesRandomnessUntouched(t *testing.T) {
+	build := func() *Bst[int, string] {
+		tree := NewBst[int, string](nil, WithSeed(1))
+		for _, key := range rand.New(rand.NewSource(2)).Perm(100) {
+			tree.Insert(key, valFor(key))
+		}
+		return tree
+	}
+	deleteAll := func(tree *Bst[int, string]) {
+		for _, key := range rand.New(rand.NewSource(3)).Perm(100)[:50] {
+			assert.NoError(t, tree.Delete(key))
+		}
+	}
+
+	tests := map[string]struct {
+		copy func(tree *Bst[int, string])
+	}{
+		"clone": {
+			copy: func(tree *Bst[int, string]) { tree.Clone() },
+		},
+		"snapshot": {
+			copy: func(tree *Bst[int, string]) { tree.Snapshot() },
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			expected := build()
+			deleteAll(expected)
+
+			tree := build()
+			test.copy(tree)
+			deleteAll(tree)
+			assertBstEqual(t, expected, tree)
+		})
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	tests := map[string]struct {
+		modify func(*Bst[int, string])
+		// expectedShared is the number of nodes still shared with the snapshot after modify
+		expectedShared int
+	}{
+		"no modification": {
+			modify:         func(*Bst[int, string]) {},
+			expectedShared: 6,
+		},
+		"insert": {
+			modify: func(tree *Bst[int, string]) {
+				tree.Insert(26, valFor(26))
+			},
+			expectedShared: 3,
+		},
+		"overwrite": {
+			modify: func(tree *Bst[int, string]) {
+				tree.Insert(25, "newVal25")
+			},
+			expectedShared: 3,
+		},
+		"delete leaf": {
+			modify: func(tree *Bst[int, string]) {
+				_ = tree.Delete(40)
+			},
+			expectedShared: 3,
+		},
+		"delete node with two children": {
+			modify: func(tree *Bst[int, string]) {
+				_ = tree.Delete(20)
+			},
+			expectedShared: 3,
+		},
+		"delete every node": {
+			modify: func(tree *Bst[int, string]) {
+				for !tree.IsEmpty() {
+					_, _, _ = tree.DeleteMax()
+				}
+			},
+			expectedShared: 0,
+		},
+		"DeleteMin": {
+			modify: func(tree *Bst[int, string]) {
+				_, _, _ = tree.DeleteMin()
+			},
+			expectedShared: 4,
+		},
+		"rotate": {
+			modify: func(tree *Bst[int, string]) {
+				_ = tree.RotateLeftRight(20)
+			},
+			expectedShared: 3,
+		},
+		"rebalance": {
+			modify: func(tree *Bst[int, string]) {
+				tree.Rebalance()
+			},
+			expectedShared: 0,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := newSnapshotTestTree()
+			snapshot := tree.Snapshot()
+			assertBstEqual(t, tree, snapshot)
+
+			test.modify(tree)
+			assertBstEqual(t, newSnapshotTestTree(), snapshot)
+			a.Equal(test.expectedShared, countShared(tree, snapshot))
+
+			valid, err := snapshot.Validate()
+			a.NoError(err)
+			a.True(valid)
+			if tree.IsEmpty() {
+				return
+			}
+			valid, err = tree.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestSnapshotModifiedIndependently(t *testing.T) {
+	a := assert.New(t)
+	tree := newSnapshotTestTree()
+	snapshot := tree.Snapshot()
+
+	// modifying the snapshot does not affect the original
+	snapshot.Insert(35, valFor(35))
+	a.NoError(snapshot.Delete(10))
+	assertBstEqual(t, newSnapshotTestTree(), tree)
+	a.Equal([]int{15, 20, 25, 30, 35, 40}, iteratedKeys(snapshot.InOrder()))
+}
+
+func TestSnapshotRandom(t *testing.T) {
+	a := assert.New(t)
+	r := rand.New(rand.NewSource(1))
+	tree := NewBst[int, string](nil, WithRand(r))
+
+	// each snapshot is checked against a deep copy taken at the same time
+	snapshots := []*Bst[int, string]{}
+	clones := []*Bst[int, string]{}
+	for i := 0; i < 2000; i++ {
+		key := r.Intn(200)
+		if r.Intn(3) == 0 {
+			_ = tree.Delete(key)
+		} else {
+			tree.Insert(key, valFor(key))
+		}
+		if i%100 == 0 {
+			snapshots = append(snapshots, tree.Snapshot())
+			clones = append(clones, tree.Clone())
+		}
+	}
+
+	for i, snapshot := range snapshots {
+		assertBstEqual(t, clones[i], snapshot)
+	}
+	valid, err := tree.Validate()
+	a.NoError(err)
+	a.True(valid)
+}
+
+func TestSnapshotConcurrentRead(t *testing.T) {
+	a := assert.New(t)
+	tree := NewBst[int, string](nil, WithSeed(1))
+	for _, key := range rand.New(rand.NewSource(1)).Perm(200) {
+		tree.Insert(key, valFor(key))
+	}
+	snapshot := tree.Snapshot()
+	expectedKeys := iteratedKeys(snapshot.InOrder())
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		r := rand.New(rand.NewSource(2))
+		for i := 0; i < 1000; i++ {
+			key := r.Intn(400)
+			if r.Intn(2) == 0 {
+				_ = tree.Delete(key)
+				continue
+			}
+			tree.Insert(key, "newVal")
+		}
+	}()
+
+	for i := 0; i < 20; i++ {
+		a.Equal(expectedKeys, iteratedKeys(snapshot.InOrder()))
+		for key, val := range snapshot.All() {
+			a.Equal(valFor(key), val)
+		}
+	}
+	wg.Wait()
+}
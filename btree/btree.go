@@ -0,0 +1,437 @@
+package btree
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/dkaslovsky/search-structures/queue"
+)
+
+// Errors returned from a BTree
+var (
+	ErrEmpty         error = errors.New("BTree is empty")
+	ErrKeyNotFound   error = errors.New("key not found in BTree")
+	ErrInvalidDegree error = errors.New("minimum degree of BTree must be at least 2")
+	ErrIteratorStop  error = errors.New("iterator stopped after iterating all items")
+)
+
+// BTree is an in-memory B-tree in which every node other than the root holds between t-1 and 2t-1
+// keys, for a configurable minimum degree t, and all leaves are at the same depth
+type BTree[K any, V any] struct {
+	Tree      *Node[K, V]
+	minDegree int
+	cmp       func(a, b K) int
+}
+
+// NewBTree constructs an empty BTree with the given minimum degree ordered by the natural ordering
+// of its keys
+func NewBTree[K cmp.Ordered, V any](minDegree int) (*BTree[K, V], error) {
+	return NewBTreeFunc[K, V](minDegree, cmp.Compare[K])
+}
+
+// NewBTreeFunc constructs an empty BTree with the given minimum degree ordered by a comparison
+// function that returns a negative number when a < b, a positive number when a > b, and zero when
+// a == b
+func NewBTreeFunc[K any, V any](minDegree int, cmp func(a, b K) int) (*BTree[K, V], error) {
+	if minDegree < 2 {
+		return nil, ErrInvalidDegree
+	}
+	return &BTree[K, V]{
+		minDegree: minDegree,
+		cmp:       cmp,
+	}, nil
+}
+
+// Item is a key/value pair stored in a BTree
+type Item[K any, V any] struct {
+	Key K
+	Val V
+}
+
+// Node is a node of a BTree holding sorted Items and, for internal nodes, one more child than items
+type Node[K any, V any] struct {
+	Items    []Item[K, V]
+	Children []*Node[K, V]
+}
+
+// IsLeaf evaluates if a node is a leaf
+func (n *Node[K, V]) IsLeaf() bool {
+	return len(n.Children) == 0
+}
+
+// MinDegree returns the minimum degree of a BTree
+func (b *BTree[K, V]) MinDegree() int {
+	return b.minDegree
+}
+
+// IsEmpty evaluates if a BTree is empty
+func (b *BTree[K, V]) IsEmpty() bool {
+	return b.Tree == nil
+}
+
+// Insert inserts a key/value pair
+func (b *BTree[K, V]) Insert(key K, val V) {
+	if b.IsEmpty() {
+		b.Tree = &Node[K, V]{
+			Items: []Item[K, V]{{Key: key, Val: val}},
+		}
+		return
+	}
+
+	// split a full root before descending so that the tree grows at the top
+	if len(b.Tree.Items) == b.maxItems() {
+		root := &Node[K, V]{
+			Children: []*Node[K, V]{b.Tree},
+		}
+		b.splitChild(root, 0)
+		b.Tree = root
+	}
+
+	// descend, splitting any full child before moving into it so that a split never propagates upward
+	curNode := b.Tree
+	for {
+		i, found := b.find(curNode, key)
+		if found {
+			// allow an existing value to be overwritten
+			curNode.Items[i].Val = val
+			return
+		}
+		if curNode.IsLeaf() {
+			curNode.Items = slices.Insert(curNode.Items, i, Item[K, V]{Key: key, Val: val})
+			return
+		}
+		if len(curNode.Children[i].Items) == b.maxItems() {
+			b.splitChild(curNode, i)
+			c := b.cmp(key, curNode.Items[i].Key)
+			if c == 0 {
+				curNode.Items[i].Val = val
+				return
+			}
+			if c > 0 {
+				i++
+			}
+		}
+		curNode = curNode.Children[i]
+	}
+}
+
+// Delete deletes a key/value pair
+func (b *BTree[K, V]) Delete(key K) error {
+	if b.IsEmpty() {
+		return ErrEmpty
+	}
+
+	found := b.delete(b.Tree, key)
+
+	// shrink the tree when the root has been emptied
+	if len(b.Tree.Items) == 0 {
+		if b.Tree.IsLeaf() {
+			b.Tree = nil
+		} else {
+			b.Tree = b.Tree.Children[0]
+		}
+	}
+
+	if !found {
+		return ErrKeyNotFound
+	}
+	return nil
+}
+
+// Search searches a BTree for a key
+func (b *BTree[K, V]) Search(key K) (val V, found bool) {
+	curNode := b.Tree
+	for curNode != nil {
+		i, found := b.find(curNode, key)
+		if found {
+			return curNode.Items[i].Val, true
+		}
+		if curNode.IsLeaf() {
+			break
+		}
+		curNode = curNode.Children[i]
+	}
+	return val, false
+}
+
+// Range creates a function to iterate, in ascending key order, the items with keys between lo and
+// hi inclusive by returning the next item on each call
+func (b *BTree[K, V]) Range(lo K, hi K) func() (Item[K, V], error) {
+	type frame struct {
+		node *Node[K, V]
+		idx  int
+	}
+
+	// seed the stack with the path to the first key greater than or equal to lo, where each frame
+	// records the index of the next item of its node to visit
+	stack := []frame{}
+	curNode := b.Tree
+	for curNode != nil {
+		i, found := b.find(curNode, lo)
+		stack = append(stack, frame{curNode, i})
+		if found || curNode.IsLeaf() {
+			break
+		}
+		curNode = curNode.Children[i]
+	}
+
+	return func() (item Item[K, V], err error) {
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			if top.idx >= len(top.node.Items) {
+				stack = stack[:len(stack)-1]
+				continue
+			}
+
+			item = top.node.Items[top.idx]
+			if b.cmp(item.Key, hi) > 0 {
+				stack = nil
+				break
+			}
+			top.idx++
+
+			// descend to the leftmost leaf of the subtree following the item
+			if !top.node.IsLeaf() {
+				child := top.node.Children[top.idx]
+				for child != nil {
+					stack = append(stack, frame{child, 0})
+					if child.IsLeaf() {
+						break
+					}
+					child = child.Children[0]
+				}
+			}
+			return item, nil
+		}
+		return item, ErrIteratorStop
+	}
+}
+
+// Validate determines if a BTree satisfies the key ordering, node fill factor, and uniform leaf
+// depth properties, returning an error describing the first violation found
+func (b *BTree[K, V]) Validate() (bool, error) {
+	if b.IsEmpty() {
+		return false, ErrEmpty
+	}
+	if _, err := b.validate(b.Tree, nil, nil, true); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Iterator creates a function to iterate the nodes of the BTree by returning the next (breadth-first) node on each call
+func (b *BTree[K, V]) Iterator() func() (*Node[K, V], error) {
+	if b.IsEmpty() {
+		return func() (*Node[K, V], error) {
+			return nil, ErrIteratorStop
+		}
+	}
+
+	q := queue.NewQueue[*Node[K, V]]()
+	q.Push(b.Tree)
+	return func() (*Node[K, V], error) {
+		curNode, err := q.Pop()
+		if err == queue.ErrEmptyQueue {
+			return nil, ErrIteratorStop
+		}
+		for _, child := range curNode.Children {
+			q.Push(child)
+		}
+		return curNode, nil
+	}
+}
+
+// find returns the index of the first item of a node with key greater than or equal to key and
+// whether that item's key is equal to key
+func (b *BTree[K, V]) find(n *Node[K, V], key K) (int, bool) {
+	return slices.BinarySearchFunc(n.Items, key, func(item Item[K, V], key K) int {
+		return b.cmp(item.Key, key)
+	})
+}
+
+func (b *BTree[K, V]) maxItems() int {
+	return 2*b.minDegree - 1
+}
+
+func (b *BTree[K, V]) minItems() int {
+	return b.minDegree - 1
+}
+
+// splitChild splits the full child at index i of n into two nodes, moving the child's median item up into n
+func (b *BTree[K, V]) splitChild(n *Node[K, V], i int) {
+	t := b.minDegree
+	child := n.Children[i]
+
+	sibling := &Node[K, V]{
+		Items: slices.Clone(child.Items[t:]),
+	}
+	if !child.IsLeaf() {
+		sibling.Children = slices.Clone(child.Children[t:])
+		child.Children = slices.Clip(child.Children[:t])
+	}
+	median := child.Items[t-1]
+	child.Items = slices.Clip(child.Items[:t-1])
+
+	n.Items = slices.Insert(n.Items, i, median)
+	n.Children = slices.Insert(n.Children, i+1, sibling)
+}
+
+// delete deletes a key from the subtree rooted at n, which is guaranteed to hold at least t items
+// unless it is the root, and returns success bool
+func (b *BTree[K, V]) delete(n *Node[K, V], key K) bool {
+	t := b.minDegree
+	i, found := b.find(n, key)
+
+	if n.IsLeaf() {
+		if !found {
+			return false
+		}
+		n.Items = slices.Delete(n.Items, i, i+1)
+		return true
+	}
+
+	if found {
+		// replace the key with its predecessor or successor when the corresponding child can spare
+		// an item, otherwise merge the children around the key and delete from the merged node
+		if len(n.Children[i].Items) >= t {
+			pred := n.Children[i].findRightMost()
+			n.Items[i] = pred
+			return b.delete(n.Children[i], pred.Key)
+		}
+		if len(n.Children[i+1].Items) >= t {
+			succ := n.Children[i+1].findLeftMost()
+			n.Items[i] = succ
+			return b.delete(n.Children[i+1], succ.Key)
+		}
+		b.mergeChildren(n, i)
+		return b.delete(n.Children[i], key)
+	}
+
+	// ensure the child to descend into holds at least t items
+	if len(n.Children[i].Items) < t {
+		i = b.fillChild(n, i)
+	}
+	return b.delete(n.Children[i], key)
+}
+
+// fillChild grows the child at index i of n, which holds t-1 items, by borrowing from a sibling or
+// merging with one, and returns the index of the child that now covers the original child's keys
+func (b *BTree[K, V]) fillChild(n *Node[K, V], i int) int {
+	t := b.minDegree
+
+	if i > 0 && len(n.Children[i-1].Items) >= t {
+		// borrow from the left sibling through the separating item
+		child, left := n.Children[i], n.Children[i-1]
+		child.Items = slices.Insert(child.Items, 0, n.Items[i-1])
+		n.Items[i-1] = left.Items[len(left.Items)-1]
+		left.Items = left.Items[:len(left.Items)-1]
+		if !left.IsLeaf() {
+			child.Children = slices.Insert(child.Children, 0, left.Children[len(left.Children)-1])
+			left.Children = left.Children[:len(left.Children)-1]
+		}
+		return i
+	}
+
+	if i < len(n.Children)-1 && len(n.Children[i+1].Items) >= t {
+		// borrow from the right sibling through the separating item
+		child, right := n.Children[i], n.Children[i+1]
+		child.Items = append(child.Items, n.Items[i])
+		n.Items[i] = right.Items[0]
+		right.Items = slices.Delete(right.Items, 0, 1)
+		if !right.IsLeaf() {
+			child.Children = append(child.Children, right.Children[0])
+			right.Children = slices.Delete(right.Children, 0, 1)
+		}
+		return i
+	}
+
+	if i < len(n.Children)-1 {
+		b.mergeChildren(n, i)
+		return i
+	}
+	b.mergeChildren(n, i-1)
+	return i - 1
+}
+
+// mergeChildren merges the child at index i+1 of n and the separating item into the child at index i
+func (b *BTree[K, V]) mergeChildren(n *Node[K, V], i int) {
+	child, right := n.Children[i], n.Children[i+1]
+	child.Items = append(child.Items, n.Items[i])
+	child.Items = append(child.Items, right.Items...)
+	child.Children = append(child.Children, right.Children...)
+
+	n.Items = slices.Delete(n.Items, i, i+1)
+	n.Children = slices.Delete(n.Children, i+1, i+2)
+}
+
+// validate checks the subtree rooted at n against exclusive key bounds, where a nil bound indicates
+// that the subtree is unbounded on that side, and returns the depth of its leaves
+func (b *BTree[K, V]) validate(n *Node[K, V], minKey *K, maxKey *K, isRoot bool) (int, error) {
+	if len(n.Items) > b.maxItems() {
+		return 0, fmt.Errorf("node holds %d items, more than the maximum of %d", len(n.Items), b.maxItems())
+	}
+	if !isRoot && len(n.Items) < b.minItems() {
+		return 0, fmt.Errorf("node holds %d items, fewer than the minimum of %d", len(n.Items), b.minItems())
+	}
+	if len(n.Items) == 0 {
+		return 0, errors.New("node holds no items")
+	}
+	if !n.IsLeaf() && len(n.Children) != len(n.Items)+1 {
+		return 0, fmt.Errorf("node holds %d items and %d children", len(n.Items), len(n.Children))
+	}
+
+	for i, item := range n.Items {
+		lo, hi := minKey, maxKey
+		if i > 0 {
+			lo = &n.Items[i-1].Key
+		}
+		if i < len(n.Items)-1 {
+			hi = &n.Items[i+1].Key
+		}
+		if (lo != nil && b.cmp(item.Key, *lo) <= 0) || (hi != nil && b.cmp(item.Key, *hi) >= 0) {
+			return 0, fmt.Errorf("item with key %v is out of order", item.Key)
+		}
+	}
+
+	if n.IsLeaf() {
+		return 1, nil
+	}
+
+	depth := 0
+	for i, child := range n.Children {
+		lo, hi := minKey, maxKey
+		if i > 0 {
+			lo = &n.Items[i-1].Key
+		}
+		if i < len(n.Items) {
+			hi = &n.Items[i].Key
+		}
+		childDepth, err := b.validate(child, lo, hi, false)
+		if err != nil {
+			return 0, err
+		}
+		if i > 0 && childDepth != depth {
+			return 0, fmt.Errorf("leaves below item with key %v are not all at the same depth", n.Items[i-1].Key)
+		}
+		depth = childDepth
+	}
+	return depth + 1, nil
+}
+
+func (n *Node[K, V]) findLeftMost() Item[K, V] {
+	curNode := n
+	for !curNode.IsLeaf() {
+		curNode = curNode.Children[0]
+	}
+	return curNode.Items[0]
+}
+
+func (n *Node[K, V]) findRightMost() Item[K, V] {
+	curNode := n
+	for !curNode.IsLeaf() {
+		curNode = curNode.Children[len(curNode.Children)-1]
+	}
+	return curNode.Items[len(curNode.Items)-1]
+}
@@ -0,0 +1,478 @@
+package btree
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTree(t *testing.T, minDegree int, keys ...int) *BTree[int, string] {
+	tree, err := NewBTree[int, string](minDegree)
+	assert.NoError(t, err)
+	for _, key := range keys {
+		tree.Insert(key, valFor(key))
+	}
+	return tree
+}
+
+func collect(next func() (Item[int, string], error)) []int {
+	keys := []int{}
+	for {
+		item, err := next()
+		if err == ErrIteratorStop {
+			return keys
+		}
+		keys = append(keys, item.Key)
+	}
+}
+
+func TestNewBTree(t *testing.T) {
+	tests := map[string]struct {
+		minDegree   int
+		expectedErr error
+	}{
+		"minimum degree too small": {
+			minDegree:   1,
+			expectedErr: ErrInvalidDegree,
+		},
+		"minimum degree of two": {
+			minDegree:   2,
+			expectedErr: nil,
+		},
+		"large minimum degree": {
+			minDegree:   64,
+			expectedErr: nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree, err := NewBTree[int, string](test.minDegree)
+			a.Equal(test.expectedErr, err)
+			if err != nil {
+				return
+			}
+			a.Equal(test.minDegree, tree.MinDegree())
+			a.True(tree.IsEmpty())
+		})
+	}
+}
+
+func TestInsert(t *testing.T) {
+	tests := map[string]struct {
+		minDegree      int
+		insertKeys     []int
+		expectedLevels [][][]int
+	}{
+		"single insert": {
+			minDegree:      2,
+			insertKeys:     []int{10},
+			expectedLevels: [][][]int{{{10}}},
+		},
+		"insert fills root": {
+			minDegree:      2,
+			insertKeys:     []int{30, 10, 20},
+			expectedLevels: [][][]int{{{10, 20, 30}}},
+		},
+		"insert splits full root": {
+			minDegree:  2,
+			insertKeys: []int{30, 10, 20, 40},
+			expectedLevels: [][][]int{
+				{{20}},
+				{{10}, {30, 40}},
+			},
+		},
+		"insert splits full child": {
+			minDegree:  2,
+			insertKeys: []int{10, 20, 30, 40, 50, 60},
+			expectedLevels: [][][]int{
+				{{20, 40}},
+				{{10}, {30}, {50, 60}},
+			},
+		},
+		"duplicate insert does not grow tree": {
+			minDegree:      2,
+			insertKeys:     []int{10, 20, 10, 20},
+			expectedLevels: [][][]int{{{10, 20}}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := newTestTree(t, test.minDegree, test.insertKeys...)
+			a.Equal(test.expectedLevels, levels(tree))
+
+			valid, err := tree.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestInsertOverwrite(t *testing.T) {
+	a := assert.New(t)
+	tree := newTestTree(t, 2, 10, 20, 30, 40)
+	tree.Insert(20, "newVal20")
+	tree.Insert(40, "newVal40")
+
+	val, found := tree.Search(20)
+	a.True(found)
+	a.Equal("newVal20", val)
+	val, found = tree.Search(40)
+	a.True(found)
+	a.Equal("newVal40", val)
+}
+
+func TestSearch(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys     []int
+		searchKey      int
+		expectedExists bool
+	}{
+		"empty tree": {
+			insertKeys:     []int{},
+			searchKey:      1,
+			expectedExists: false,
+		},
+		"tree without searchKey": {
+			insertKeys:     []int{10, 20, 30, 40, 50, 60},
+			searchKey:      35,
+			expectedExists: false,
+		},
+		"tree with searchKey in internal node": {
+			insertKeys:     []int{10, 20, 30, 40, 50, 60},
+			searchKey:      40,
+			expectedExists: true,
+		},
+		"tree with searchKey in leaf": {
+			insertKeys:     []int{10, 20, 30, 40, 50, 60},
+			searchKey:      60,
+			expectedExists: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := newTestTree(t, 2, test.insertKeys...)
+			val, exists := tree.Search(test.searchKey)
+			a.Equal(test.expectedExists, exists)
+			if !test.expectedExists {
+				return
+			}
+			a.Equal(valFor(test.searchKey), val)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys     []int
+		deleteKey      int
+		expectedLevels [][][]int
+		expectedErr    error
+	}{
+		"empty tree": {
+			insertKeys:  []int{},
+			deleteKey:   1,
+			expectedErr: ErrEmpty,
+		},
+		"tree without deleteKey": {
+			insertKeys:  []int{10, 20, 30},
+			deleteKey:   1,
+			expectedErr: ErrKeyNotFound,
+		},
+		"single item tree": {
+			insertKeys:     []int{10},
+			deleteKey:      10,
+			expectedLevels: [][][]int{},
+		},
+		"delete from leaf": {
+			insertKeys: []int{10, 20, 30, 40, 50, 60},
+			deleteKey:  60,
+			expectedLevels: [][][]int{
+				{{20, 40}},
+				{{10}, {30}, {50}},
+			},
+		},
+		"delete from leaf borrowing from sibling": {
+			insertKeys: []int{10, 20, 30, 40, 50, 60},
+			deleteKey:  30,
+			expectedLevels: [][][]int{
+				{{20, 50}},
+				{{10}, {40}, {60}},
+			},
+		},
+		"delete from internal node using predecessor": {
+			insertKeys: []int{10, 20, 30, 40, 50, 60, 35},
+			deleteKey:  40,
+			expectedLevels: [][][]int{
+				{{20, 35}},
+				{{10}, {30}, {50, 60}},
+			},
+		},
+		"delete from internal node using successor": {
+			insertKeys: []int{10, 20, 30, 40},
+			deleteKey:  20,
+			expectedLevels: [][][]int{
+				{{30}},
+				{{10}, {40}},
+			},
+		},
+		"delete from internal node merging children": {
+			insertKeys: []int{10, 20, 30, 40, 50, 60},
+			deleteKey:  20,
+			expectedLevels: [][][]int{
+				{{40}},
+				{{10, 30}, {50, 60}},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := newTestTree(t, 2, test.insertKeys...)
+			err := tree.Delete(test.deleteKey)
+			if test.expectedErr != nil {
+				a.Equal(test.expectedErr, err)
+				return
+			}
+			a.NoError(err)
+			a.Equal(test.expectedLevels, levels(tree))
+
+			if tree.IsEmpty() {
+				return
+			}
+			valid, err := tree.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestRandomInsertDelete(t *testing.T) {
+	for _, minDegree := range []int{2, 3, 5, 16} {
+		t.Run(fmt.Sprintf("minimum degree %d", minDegree), func(t *testing.T) {
+			a := assert.New(t)
+			r := rand.New(rand.NewSource(1))
+			n := 1000
+			tree := newTestTree(t, minDegree, r.Perm(n)...)
+
+			valid, err := tree.Validate()
+			a.NoError(err)
+			a.True(valid)
+
+			deleted := map[int]bool{}
+			for _, key := range r.Perm(n)[:n/2] {
+				a.NoError(tree.Delete(key))
+				deleted[key] = true
+				valid, err := tree.Validate()
+				a.NoError(err)
+				a.True(valid)
+			}
+			for key := 0; key < n; key++ {
+				_, found := tree.Search(key)
+				a.Equal(!deleted[key], found)
+			}
+		})
+	}
+}
+
+func TestDeleteAll(t *testing.T) {
+	a := assert.New(t)
+	n := 100
+	tree := newTestTree(t, 2)
+	for key := 0; key < n; key++ {
+		tree.Insert(key, valFor(key))
+	}
+	for key := 0; key < n; key++ {
+		a.NoError(tree.Delete(key))
+		if tree.IsEmpty() {
+			break
+		}
+		valid, err := tree.Validate()
+		a.NoError(err)
+		a.True(valid)
+	}
+	a.True(tree.IsEmpty())
+}
+
+func TestRange(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys   []int
+		lo           int
+		hi           int
+		expectedKeys []int
+	}{
+		"empty tree": {
+			insertKeys:   []int{},
+			lo:           1,
+			hi:           10,
+			expectedKeys: []int{},
+		},
+		"full range": {
+			insertKeys:   []int{50, 10, 40, 20, 30, 60, 70},
+			lo:           0,
+			hi:           100,
+			expectedKeys: []int{10, 20, 30, 40, 50, 60, 70},
+		},
+		"bounds on existing keys are inclusive": {
+			insertKeys:   []int{50, 10, 40, 20, 30, 60, 70},
+			lo:           20,
+			hi:           60,
+			expectedKeys: []int{20, 30, 40, 50, 60},
+		},
+		"bounds between keys": {
+			insertKeys:   []int{50, 10, 40, 20, 30, 60, 70},
+			lo:           15,
+			hi:           45,
+			expectedKeys: []int{20, 30, 40},
+		},
+		"empty range": {
+			insertKeys:   []int{50, 10, 40, 20, 30, 60, 70},
+			lo:           41,
+			hi:           49,
+			expectedKeys: []int{},
+		},
+		"inverted range": {
+			insertKeys:   []int{50, 10, 40, 20, 30, 60, 70},
+			lo:           60,
+			hi:           20,
+			expectedKeys: []int{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			tree := newTestTree(t, 2, test.insertKeys...)
+			assert.Equal(t, test.expectedKeys, collect(tree.Range(test.lo, test.hi)))
+		})
+	}
+}
+
+func TestRangeLarge(t *testing.T) {
+	a := assert.New(t)
+	r := rand.New(rand.NewSource(1))
+	tree := newTestTree(t, 3, r.Perm(500)...)
+
+	expected := []int{}
+	for key := 123; key <= 456; key++ {
+		expected = append(expected, key)
+	}
+	a.Equal(expected, collect(tree.Range(123, 456)))
+}
+
+func TestValidate(t *testing.T) {
+	leaf := func(keys ...int) *Node[int, string] {
+		n := &Node[int, string]{}
+		for _, key := range keys {
+			n.Items = append(n.Items, Item[int, string]{Key: key})
+		}
+		return n
+	}
+
+	tests := map[string]struct {
+		tree          *Node[int, string]
+		expectedValid bool
+		expectedErr   bool
+	}{
+		"valid tree": {
+			tree: &Node[int, string]{
+				Items:    leaf(20).Items,
+				Children: []*Node[int, string]{leaf(10), leaf(30, 40)},
+			},
+			expectedValid: true,
+		},
+		"overfull node": {
+			tree:          leaf(10, 20, 30, 40),
+			expectedValid: false,
+			expectedErr:   true,
+		},
+		"underfull node": {
+			tree: &Node[int, string]{
+				Items:    leaf(20).Items,
+				Children: []*Node[int, string]{leaf(), leaf(30, 40)},
+			},
+			expectedValid: false,
+			expectedErr:   true,
+		},
+		"items out of order": {
+			tree:          leaf(10, 30, 20),
+			expectedValid: false,
+			expectedErr:   true,
+		},
+		"child keys out of order": {
+			tree: &Node[int, string]{
+				Items:    leaf(20).Items,
+				Children: []*Node[int, string]{leaf(10), leaf(15, 40)},
+			},
+			expectedValid: false,
+			expectedErr:   true,
+		},
+		"leaves at different depths": {
+			tree: &Node[int, string]{
+				Items: leaf(20).Items,
+				Children: []*Node[int, string]{
+					leaf(10),
+					{
+						Items:    leaf(40).Items,
+						Children: []*Node[int, string]{leaf(30), leaf(50)},
+					},
+				},
+			},
+			expectedValid: false,
+			expectedErr:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := newTestTree(t, 2)
+			tree.Tree = test.tree
+			valid, err := tree.Validate()
+			a.Equal(test.expectedValid, valid)
+			a.Equal(test.expectedErr, err != nil)
+		})
+	}
+
+	t.Run("empty tree", func(t *testing.T) {
+		a := assert.New(t)
+		valid, err := newTestTree(t, 2).Validate()
+		a.False(valid)
+		a.Equal(ErrEmpty, err)
+	})
+}
+
+// levels returns the keys of each node of a BTree grouped by depth
+func levels(tree *BTree[int, string]) [][][]int {
+	result := [][][]int{}
+	level := []*Node[int, string]{}
+	if !tree.IsEmpty() {
+		level = append(level, tree.Tree)
+	}
+	for len(level) > 0 {
+		keys := [][]int{}
+		next := []*Node[int, string]{}
+		for _, n := range level {
+			nodeKeys := []int{}
+			for _, item := range n.Items {
+				nodeKeys = append(nodeKeys, item.Key)
+			}
+			keys = append(keys, nodeKeys)
+			next = append(next, n.Children...)
+		}
+		result = append(result, keys)
+		level = next
+	}
+	return result
+}
+
+func valFor(key int) string {
+	return fmt.Sprintf("val%d", key)
+}
@@ -1,29 +1,92 @@
 package queue
 
-import "errors"
+import (
+	"errors"
+	"iter"
+)
 
-var ErrEmptyQueue = errors.New("cannot pop from empty queue")
+// Errors returned from a Queue
+var (
+	ErrEmptyQueue = errors.New("cannot pop from empty queue")
+)
 
-type Queue struct {
-	data []interface{}
+// Queue is a first-in-first-out queue of items of type T
+type Queue[T any] struct {
+	data []T
 }
 
-func NewQueue() (q *Queue) {
-	return &Queue{
-		data: []interface{}{},
+// NewQueue constructs a Queue
+func NewQueue[T any]() (q *Queue[T]) {
+	return &Queue[T]{
+		data: []T{},
 	}
 }
 
-func (q *Queue) Push(i interface{}) {
+// Push adds an item to the back of the queue
+func (q *Queue[T]) Push(i T) {
 	q.data = append(q.data, i)
 }
 
-func (q *Queue) Pop() (i interface{}, err error) {
+// Pop removes and returns the item at the front of the queue
+func (q *Queue[T]) Pop() (i T, err error) {
 	if len(q.data) == 0 {
 		return i, ErrEmptyQueue
 	}
-	item, items := q.data[0], q.data[1:]
-	q.data = items
-	items = nil
+	item := q.data[0]
+	// zero the vacated slot so the queue does not hold a reference to the popped item
+	var zero T
+	q.data[0] = zero
+	q.data = q.data[1:]
 	return item, nil
 }
+
+// Peek returns the item at the front of the queue without removing it
+func (q *Queue[T]) Peek() (i T, err error) {
+	if len(q.data) == 0 {
+		return i, ErrEmptyQueue
+	}
+	return q.data[0], nil
+}
+
+// Len returns the number of items in the queue
+func (q *Queue[T]) Len() int {
+	return len(q.data)
+}
+
+// IsEmpty evaluates if the queue is empty
+func (q *Queue[T]) IsEmpty() bool {
+	return len(q.data) == 0
+}
+
+// Clear removes all items from the queue
+func (q *Queue[T]) Clear() {
+	q.data = []T{}
+}
+
+// All returns an iterator that yields items from the front to the back of the queue without removing
+// them; the queue should not be modified while iterating
+func (q *Queue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range q.data {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Drain returns an iterator that pops and yields items from the front of the queue until it is empty,
+// including items pushed while iterating
+func (q *Queue[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			item, err := q.Pop()
+			if err == ErrEmptyQueue {
+				return
+			}
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPushPop(t *testing.T) {
+	tests := map[string]struct {
+		items []int
+	}{
+		"no items": {
+			items: []int{},
+		},
+		"single item": {
+			items: []int{1},
+		},
+		"multiple items": {
+			items: []int{3, 1, 2},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			q := NewQueue[int]()
+			for _, item := range test.items {
+				q.Push(item)
+			}
+			a.Equal(len(test.items), q.Len())
+
+			popped := []int{}
+			for {
+				item, err := q.Pop()
+				if err == ErrEmptyQueue {
+					break
+				}
+				popped = append(popped, item)
+			}
+			a.Equal(test.items, popped)
+			a.True(q.IsEmpty())
+		})
+	}
+}
+
+func TestPeek(t *testing.T) {
+	t.Run("empty queue", func(t *testing.T) {
+		q := NewQueue[string]()
+		_, err := q.Peek()
+		assert.Equal(t, ErrEmptyQueue, err)
+	})
+
+	t.Run("nonempty queue", func(t *testing.T) {
+		a := assert.New(t)
+		q := NewQueue[string]()
+		q.Push("a")
+		q.Push("b")
+		item, err := q.Peek()
+		a.NoError(err)
+		a.Equal("a", item)
+		a.Equal(2, q.Len())
+	})
+}
+
+func TestClear(t *testing.T) {
+	a := assert.New(t)
+	q := NewQueue[int]()
+	q.Push(1)
+	q.Push(2)
+	q.Clear()
+	a.True(q.IsEmpty())
+	a.Equal(0, q.Len())
+
+	_, err := q.Pop()
+	a.Equal(ErrEmptyQueue, err)
+}
+
+func TestAll(t *testing.T) {
+	tests := map[string]struct {
+		items []int
+	}{
+		"empty queue": {
+			items: []int{},
+		},
+		"multiple items": {
+			items: []int{1, 2, 3},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			q := NewQueue[int]()
+			for _, item := range test.items {
+				q.Push(item)
+			}
+
+			items := []int{}
+			for item := range q.All() {
+				items = append(items, item)
+			}
+			a.Equal(test.items, items)
+			a.Equal(len(test.items), q.Len())
+		})
+	}
+
+	t.Run("break", func(t *testing.T) {
+		a := assert.New(t)
+		q := NewQueue[int]()
+		q.Push(1)
+		q.Push(2)
+		q.Push(3)
+
+		for item := range q.All() {
+			if item == 2 {
+				break
+			}
+		}
+		a.Equal(3, q.Len())
+	})
+}
+
+func TestDrain(t *testing.T) {
+	t.Run("drains queue", func(t *testing.T) {
+		a := assert.New(t)
+		q := NewQueue[int]()
+		q.Push(1)
+		q.Push(2)
+		q.Push(3)
+
+		items := []int{}
+		for item := range q.Drain() {
+			items = append(items, item)
+		}
+		a.Equal([]int{1, 2, 3}, items)
+		a.True(q.IsEmpty())
+	})
+
+	t.Run("yields items pushed while iterating", func(t *testing.T) {
+		a := assert.New(t)
+		q := NewQueue[int]()
+		q.Push(1)
+
+		items := []int{}
+		for item := range q.Drain() {
+			items = append(items, item)
+			if item < 4 {
+				q.Push(item + 1)
+			}
+		}
+		a.Equal([]int{1, 2, 3, 4}, items)
+	})
+
+	t.Run("break leaves remaining items", func(t *testing.T) {
+		a := assert.New(t)
+		q := NewQueue[int]()
+		q.Push(1)
+		q.Push(2)
+		q.Push(3)
+
+		for item := range q.Drain() {
+			if item == 1 {
+				break
+			}
+		}
+		a.Equal(2, q.Len())
+	})
+}
@@ -0,0 +1,424 @@
+package rbt
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+
+	"github.com/dkaslovsky/search-structures/queue"
+)
+
+// Errors returned from an Rbt
+var (
+	ErrEmpty        error = errors.New("Rbt is empty")
+	ErrKeyNotFound  error = errors.New("key not found in Rbt")
+	ErrIteratorStop error = errors.New("iterator stopped after iterating all nodes")
+)
+
+// Errors describing the first violation found by Validate
+var (
+	ErrKeyOrder    error = errors.New("binary search tree property violated")
+	ErrParentLink  error = errors.New("parent link inconsistent with child link")
+	ErrRedRoot     error = errors.New("root is red")
+	ErrRedRed      error = errors.New("red node has a red child")
+	ErrBlackHeight error = errors.New("unequal black heights")
+)
+
+// Rbt is a red-black tree, a self-balancing binary search tree in which every node is colored red
+// or black such that the root is black, no red node has a red child, and every path from a node to
+// its descendant leaves contains the same number of black nodes
+type Rbt[K any, V any] struct {
+	Tree *Node[K, V]
+	cmp  func(a, b K) int
+}
+
+// NewRbt constructs an empty Rbt ordered by the natural ordering of its keys
+func NewRbt[K cmp.Ordered, V any]() *Rbt[K, V] {
+	return NewRbtFunc[K, V](cmp.Compare[K])
+}
+
+// NewRbtFunc constructs an empty Rbt ordered by a comparison function that returns a negative
+// number when a < b, a positive number when a > b, and zero when a == b
+func NewRbtFunc[K any, V any](cmp func(a, b K) int) *Rbt[K, V] {
+	return &Rbt[K, V]{
+		cmp: cmp,
+	}
+}
+
+type color bool
+
+const (
+	black color = false
+	red   color = true
+)
+
+// Node is a node of an Rbt indexed by Key containing value Val
+type Node[K any, V any] struct {
+	Key    K
+	Val    V
+	Left   *Node[K, V]
+	Right  *Node[K, V]
+	parent *Node[K, V]
+	color  color
+}
+
+// IsRed evaluates if a node is red; nil leaves are black
+func (n *Node[K, V]) IsRed() bool {
+	return n != nil && n.color == red
+}
+
+// IsEmpty evaluates if an Rbt is empty
+func (t *Rbt[K, V]) IsEmpty() bool {
+	return t.Tree == nil
+}
+
+// Insert inserts a key/value pair
+func (t *Rbt[K, V]) Insert(key K, val V) {
+	var parent *Node[K, V]
+	c := 0
+	curTree := t.Tree
+	for curTree != nil {
+		c = t.cmp(key, curTree.Key)
+		if c == 0 {
+			// allow an existing value to be overwritten
+			curTree.Val = val
+			return
+		}
+		parent = curTree
+		if c < 0 {
+			curTree = curTree.Left
+			continue
+		}
+		curTree = curTree.Right
+	}
+
+	node := &Node[K, V]{
+		Key:    key,
+		Val:    val,
+		parent: parent,
+		color:  red,
+	}
+	switch {
+	case parent == nil:
+		t.Tree = node
+	case c < 0:
+		parent.Left = node
+	default:
+		parent.Right = node
+	}
+	t.insertFixup(node)
+}
+
+// Delete deletes a key/value pair
+func (t *Rbt[K, V]) Delete(key K) error {
+	if t.IsEmpty() {
+		return ErrEmpty
+	}
+
+	target, found := t.search(key)
+	if !found {
+		return ErrKeyNotFound
+	}
+
+	// child is the node that moves into the position vacated by the removed node and parent is its
+	// new parent, tracked separately because child may be a nil leaf
+	var child, parent *Node[K, V]
+	removedColor := target.color
+
+	switch {
+	case target.Left == nil:
+		child, parent = target.Right, target.parent
+		t.transplant(target, target.Right)
+	case target.Right == nil:
+		child, parent = target.Left, target.parent
+		t.transplant(target, target.Left)
+	default:
+		// target has both left and right children: replace it with the leftmost (min) node of the
+		// right branch, which is removed from its own position
+		left := target.Right.findLeftMost()
+		removedColor = left.color
+		child = left.Right
+		if left.parent == target {
+			parent = left
+		} else {
+			parent = left.parent
+			t.transplant(left, left.Right)
+			left.Right = target.Right
+			left.Right.parent = left
+		}
+		t.transplant(target, left)
+		left.Left = target.Left
+		left.Left.parent = left
+		left.color = target.color
+	}
+
+	// removing a black node shortens every path through it, which must be repaired
+	if removedColor == black {
+		t.deleteFixup(child, parent)
+	}
+	return nil
+}
+
+// Search searches an Rbt for a key
+func (t *Rbt[K, V]) Search(key K) (val V, found bool) {
+	target, found := t.search(key)
+	if !found {
+		return val, false
+	}
+	return target.Val, true
+}
+
+// Validate determines if an Rbt satisfies the binary search tree property and the red-black
+// coloring rules, returning an error describing the first violation found
+func (t *Rbt[K, V]) Validate() (bool, error) {
+	if t.IsEmpty() {
+		return false, ErrEmpty
+	}
+	if t.Tree.IsRed() {
+		return false, ErrRedRoot
+	}
+	if t.Tree.parent != nil {
+		return false, fmt.Errorf("%w: root node with key %v has a parent", ErrParentLink, t.Tree.Key)
+	}
+
+	if _, err := t.validate(t.Tree, nil, nil); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Iterator creates a function to iterate the nodes of the Rbt by returning the next (breadth-first) node on each call
+func (t *Rbt[K, V]) Iterator() func() (*Node[K, V], error) {
+	if t.IsEmpty() {
+		return func() (*Node[K, V], error) {
+			return nil, ErrIteratorStop
+		}
+	}
+
+	q := queue.NewQueue[*Node[K, V]]()
+	q.Push(t.Tree)
+	return func() (*Node[K, V], error) {
+		curT, err := q.Pop()
+		if err == queue.ErrEmptyQueue {
+			return nil, ErrIteratorStop
+		}
+		if curT.Left != nil {
+			q.Push(curT.Left)
+		}
+		if curT.Right != nil {
+			q.Push(curT.Right)
+		}
+		return curT, nil
+	}
+}
+
+// search searches for a key and returns the node and success bool
+func (t *Rbt[K, V]) search(key K) (target *Node[K, V], found bool) {
+	curTree := t.Tree
+	for curTree != nil {
+		c := t.cmp(key, curTree.Key)
+		if c == 0 {
+			return curTree, true
+		}
+		if c < 0 {
+			curTree = curTree.Left
+			continue
+		}
+		curTree = curTree.Right
+	}
+	return target, false
+}
+
+// validate checks the subtree rooted at n against exclusive key bounds, where a nil bound indicates
+// that the subtree is unbounded on that side, and returns the subtree's black height
+func (t *Rbt[K, V]) validate(n *Node[K, V], minKey *K, maxKey *K) (int, error) {
+	if n == nil {
+		return 1, nil
+	}
+	if (minKey != nil && t.cmp(n.Key, *minKey) <= 0) || (maxKey != nil && t.cmp(n.Key, *maxKey) >= 0) {
+		return 0, fmt.Errorf("%w: node with key %v is out of order", ErrKeyOrder, n.Key)
+	}
+	for _, child := range []*Node[K, V]{n.Left, n.Right} {
+		if child == nil {
+			continue
+		}
+		if child.parent != n {
+			return 0, fmt.Errorf("%w: node with key %v is not the parent of its child with key %v", ErrParentLink, n.Key, child.Key)
+		}
+		if n.IsRed() && child.IsRed() {
+			return 0, fmt.Errorf("%w: node with key %v and its child with key %v are both red", ErrRedRed, n.Key, child.Key)
+		}
+	}
+
+	leftHeight, err := t.validate(n.Left, minKey, &n.Key)
+	if err != nil {
+		return 0, err
+	}
+	rightHeight, err := t.validate(n.Right, &n.Key, maxKey)
+	if err != nil {
+		return 0, err
+	}
+	if leftHeight != rightHeight {
+		return 0, fmt.Errorf("%w: node with key %v has left black height %d and right black height %d", ErrBlackHeight, n.Key, leftHeight, rightHeight)
+	}
+
+	if n.IsRed() {
+		return leftHeight, nil
+	}
+	return leftHeight + 1, nil
+}
+
+// insertFixup restores the red-black properties after inserting the red node n
+func (t *Rbt[K, V]) insertFixup(n *Node[K, V]) {
+	for n.parent.IsRed() {
+		// a red parent cannot be the root, so the grandparent exists
+		parent := n.parent
+		grandparent := parent.parent
+
+		if parent == grandparent.Left {
+			uncle := grandparent.Right
+			if uncle.IsRed() {
+				parent.color = black
+				uncle.color = black
+				grandparent.color = red
+				n = grandparent
+				continue
+			}
+			if n == parent.Right {
+				n = parent
+				t.rotateLeft(n)
+				parent = n.parent
+			}
+			parent.color = black
+			grandparent.color = red
+			t.rotateRight(grandparent)
+			continue
+		}
+
+		uncle := grandparent.Left
+		if uncle.IsRed() {
+			parent.color = black
+			uncle.color = black
+			grandparent.color = red
+			n = grandparent
+			continue
+		}
+		if n == parent.Left {
+			n = parent
+			t.rotateRight(n)
+			parent = n.parent
+		}
+		parent.color = black
+		grandparent.color = red
+		t.rotateLeft(grandparent)
+	}
+	t.Tree.color = black
+}
+
+// deleteFixup restores the red-black properties after removing a black node, where n (possibly a
+// nil leaf) carries an extra black and parent is its parent
+func (t *Rbt[K, V]) deleteFixup(n *Node[K, V], parent *Node[K, V]) {
+	for n != t.Tree && !n.IsRed() {
+		// the extra black on n guarantees that its sibling exists
+		if n == parent.Left {
+			sibling := parent.Right
+			if sibling.IsRed() {
+				sibling.color = black
+				parent.color = red
+				t.rotateLeft(parent)
+				sibling = parent.Right
+			}
+			if !sibling.Left.IsRed() && !sibling.Right.IsRed() {
+				sibling.color = red
+				n = parent
+				parent = n.parent
+				continue
+			}
+			if !sibling.Right.IsRed() {
+				sibling.Left.color = black
+				sibling.color = red
+				t.rotateRight(sibling)
+				sibling = parent.Right
+			}
+			sibling.color = parent.color
+			parent.color = black
+			sibling.Right.color = black
+			t.rotateLeft(parent)
+			n = t.Tree
+			continue
+		}
+
+		sibling := parent.Left
+		if sibling.IsRed() {
+			sibling.color = black
+			parent.color = red
+			t.rotateRight(parent)
+			sibling = parent.Left
+		}
+		if !sibling.Left.IsRed() && !sibling.Right.IsRed() {
+			sibling.color = red
+			n = parent
+			parent = n.parent
+			continue
+		}
+		if !sibling.Left.IsRed() {
+			sibling.Right.color = black
+			sibling.color = red
+			t.rotateLeft(sibling)
+			sibling = parent.Left
+		}
+		sibling.color = parent.color
+		parent.color = black
+		sibling.Left.color = black
+		t.rotateRight(parent)
+		n = t.Tree
+	}
+	if n != nil {
+		n.color = black
+	}
+}
+
+// transplant replaces the subtree rooted at n with the subtree rooted at replacement
+func (t *Rbt[K, V]) transplant(n *Node[K, V], replacement *Node[K, V]) {
+	switch {
+	case n.parent == nil:
+		t.Tree = replacement
+	case n == n.parent.Left:
+		n.parent.Left = replacement
+	default:
+		n.parent.Right = replacement
+	}
+	if replacement != nil {
+		replacement.parent = n.parent
+	}
+}
+
+func (t *Rbt[K, V]) rotateLeft(n *Node[K, V]) {
+	right := n.Right
+	n.Right = right.Left
+	if right.Left != nil {
+		right.Left.parent = n
+	}
+	t.transplant(n, right)
+	right.Left = n
+	n.parent = right
+}
+
+func (t *Rbt[K, V]) rotateRight(n *Node[K, V]) {
+	left := n.Left
+	n.Left = left.Right
+	if left.Right != nil {
+		left.Right.parent = n
+	}
+	t.transplant(n, left)
+	left.Right = n
+	n.parent = left
+}
+
+func (n *Node[K, V]) findLeftMost() *Node[K, V] {
+	left := n
+	for left.Left != nil {
+		left = left.Left
+	}
+	return left
+}
@@ -0,0 +1,344 @@
+package rbt
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testNode struct {
+	key   int
+	val   string
+	isRed bool
+}
+
+func iterate(tree *Rbt[int, string]) []testNode {
+	nodes := []testNode{}
+	iter := tree.Iterator()
+	for {
+		node, err := iter()
+		if err == ErrIteratorStop {
+			return nodes
+		}
+		nodes = append(nodes, testNode{node.Key, node.Val, node.IsRed()})
+	}
+}
+
+func TestInsert(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys            []int
+		expectedIteratedNodes []testNode
+	}{
+		"single insert": {
+			insertKeys:            []int{10},
+			expectedIteratedNodes: []testNode{{10, "val10", false}},
+		},
+		"insert with red uncle recolors": {
+			insertKeys: []int{20, 10, 30, 5},
+			expectedIteratedNodes: []testNode{
+				{20, "val20", false},
+				{10, "val10", false},
+				{30, "val30", false},
+				{5, "val5", true},
+			},
+		},
+		"insert with black uncle in line rotates": {
+			insertKeys: []int{10, 20, 30},
+			expectedIteratedNodes: []testNode{
+				{20, "val20", false},
+				{10, "val10", true},
+				{30, "val30", true},
+			},
+		},
+		"insert with black uncle in zigzag double rotates": {
+			insertKeys: []int{30, 10, 20},
+			expectedIteratedNodes: []testNode{
+				{20, "val20", false},
+				{10, "val10", true},
+				{30, "val30", true},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := NewRbt[int, string]()
+			for _, key := range test.insertKeys {
+				tree.Insert(key, valFor(key))
+			}
+			a.Equal(test.expectedIteratedNodes, iterate(tree))
+
+			valid, err := tree.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestInsertOverwrite(t *testing.T) {
+	a := assert.New(t)
+	tree := NewRbt[int, string]()
+	tree.Insert(10, "val10")
+	tree.Insert(10, "newVal10")
+	a.Equal([]testNode{{10, "newVal10", false}}, iterate(tree))
+}
+
+func TestSearch(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys     []int
+		searchKey      int
+		expectedExists bool
+	}{
+		"empty tree": {
+			insertKeys:     []int{},
+			searchKey:      1,
+			expectedExists: false,
+		},
+		"tree without searchKey": {
+			insertKeys:     []int{10, 8, 12},
+			searchKey:      2,
+			expectedExists: false,
+		},
+		"tree with searchKey": {
+			insertKeys:     []int{10, 8, 12},
+			searchKey:      12,
+			expectedExists: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := NewRbt[int, string]()
+			for _, key := range test.insertKeys {
+				tree.Insert(key, valFor(key))
+			}
+			val, exists := tree.Search(test.searchKey)
+			a.Equal(test.expectedExists, exists)
+			if !test.expectedExists {
+				return
+			}
+			a.Equal(valFor(test.searchKey), val)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys            []int
+		deleteKey             int
+		expectedIteratedNodes []testNode
+		expectedErr           error
+	}{
+		"empty tree": {
+			insertKeys:  []int{},
+			deleteKey:   1,
+			expectedErr: ErrEmpty,
+		},
+		"tree without deleteKey": {
+			insertKeys:  []int{10, 8, 12},
+			deleteKey:   1,
+			expectedErr: ErrKeyNotFound,
+		},
+		"single node tree": {
+			insertKeys:            []int{10},
+			deleteKey:             10,
+			expectedIteratedNodes: []testNode{},
+		},
+		"delete red leaf": {
+			insertKeys: []int{10, 8, 12},
+			deleteKey:  8,
+			expectedIteratedNodes: []testNode{
+				{10, "val10", false},
+				{12, "val12", true},
+			},
+		},
+		"delete root with two children": {
+			insertKeys: []int{10, 8, 12},
+			deleteKey:  10,
+			expectedIteratedNodes: []testNode{
+				{12, "val12", false},
+				{8, "val8", true},
+			},
+		},
+		"delete black leaf with black sibling rotates": {
+			insertKeys: []int{20, 10, 30, 40},
+			deleteKey:  10,
+			expectedIteratedNodes: []testNode{
+				{30, "val30", false},
+				{20, "val20", false},
+				{40, "val40", false},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := NewRbt[int, string]()
+			for _, key := range test.insertKeys {
+				tree.Insert(key, valFor(key))
+			}
+			err := tree.Delete(test.deleteKey)
+			if test.expectedErr != nil {
+				a.Equal(test.expectedErr, err)
+				return
+			}
+			a.NoError(err)
+			a.Equal(test.expectedIteratedNodes, iterate(tree))
+
+			if tree.IsEmpty() {
+				return
+			}
+			valid, err := tree.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestRandomInsertDelete(t *testing.T) {
+	a := assert.New(t)
+	r := rand.New(rand.NewSource(1))
+	n := 500
+	tree := NewRbt[int, string]()
+	present := map[int]bool{}
+
+	for _, key := range r.Perm(n) {
+		tree.Insert(key, valFor(key))
+		present[key] = true
+	}
+	valid, err := tree.Validate()
+	a.NoError(err)
+	a.True(valid)
+
+	// the height of a red-black tree is at most 2*log2(n+1)
+	a.LessOrEqual(float64(height(tree.Tree)), 2*math.Log2(float64(n+1)))
+
+	for _, key := range r.Perm(n)[:n/2] {
+		a.NoError(tree.Delete(key))
+		delete(present, key)
+		valid, err := tree.Validate()
+		a.NoError(err)
+		a.True(valid)
+	}
+	for key := 0; key < n; key++ {
+		_, found := tree.Search(key)
+		a.Equal(present[key], found)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	// link sets parent pointers so test trees can be written as nested literals
+	var link func(n *Node[int, string]) *Node[int, string]
+	link = func(n *Node[int, string]) *Node[int, string] {
+		for _, child := range []*Node[int, string]{n.Left, n.Right} {
+			if child != nil {
+				child.parent = n
+				link(child)
+			}
+		}
+		return n
+	}
+
+	tests := map[string]struct {
+		tree          *Node[int, string]
+		expectedValid bool
+		expectedErr   error
+	}{
+		"empty tree": {
+			tree:          nil,
+			expectedValid: false,
+			expectedErr:   ErrEmpty,
+		},
+		"valid tree": {
+			tree: link(&Node[int, string]{
+				Key:   10,
+				Left:  &Node[int, string]{Key: 8, color: red},
+				Right: &Node[int, string]{Key: 12, color: red},
+			}),
+			expectedValid: true,
+		},
+		"tree violating key ordering": {
+			tree: link(&Node[int, string]{
+				Key:   10,
+				Left:  &Node[int, string]{Key: 11, color: red},
+				Right: &Node[int, string]{Key: 12, color: red},
+			}),
+			expectedValid: false,
+			expectedErr:   ErrKeyOrder,
+		},
+		"tree with red root": {
+			tree: link(&Node[int, string]{
+				Key:   10,
+				color: red,
+			}),
+			expectedValid: false,
+			expectedErr:   ErrRedRoot,
+		},
+		"tree with red node having red child": {
+			tree: link(&Node[int, string]{
+				Key: 10,
+				Left: &Node[int, string]{
+					Key:   8,
+					Left:  &Node[int, string]{Key: 6, color: red},
+					color: red,
+				},
+				Right: &Node[int, string]{
+					Key:   12,
+					color: black,
+				},
+			}),
+			expectedValid: false,
+			expectedErr:   ErrRedRed,
+		},
+		"tree with unequal black heights": {
+			tree: link(&Node[int, string]{
+				Key:  10,
+				Left: &Node[int, string]{Key: 8},
+			}),
+			expectedValid: false,
+			expectedErr:   ErrBlackHeight,
+		},
+		"tree with inconsistent parent link": {
+			tree: &Node[int, string]{
+				Key:   10,
+				Left:  &Node[int, string]{Key: 8, color: red},
+				Right: &Node[int, string]{Key: 12, color: red},
+			},
+			expectedValid: false,
+			expectedErr:   ErrParentLink,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := NewRbt[int, string]()
+			tree.Tree = test.tree
+			valid, err := tree.Validate()
+			a.Equal(test.expectedValid, valid)
+			if test.expectedErr == nil {
+				a.NoError(err)
+				return
+			}
+			a.True(errors.Is(err, test.expectedErr), "expected %v, received %v", test.expectedErr, err)
+		})
+	}
+}
+
+func height(n *Node[int, string]) int {
+	if n == nil {
+		return 0
+	}
+	return max(height(n.Left), height(n.Right)) + 1
+}
+
+func valFor(key int) string {
+	return fmt.Sprintf("val%d", key)
+}
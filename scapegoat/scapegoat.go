@@ -0,0 +1,337 @@
+package scapegoat
+
+import (
+	"cmp"
+	"errors"
+	"math"
+
+	"github.com/dkaslovsky/search-structures/queue"
+)
+
+// Errors returned from a Scapegoat
+var (
+	ErrEmpty        error = errors.New("Scapegoat is empty")
+	ErrKeyNotFound  error = errors.New("key not found in Scapegoat")
+	ErrInvalidAlpha error = errors.New("alpha of Scapegoat must be in the interval [0.5, 1)")
+	ErrIteratorStop error = errors.New("iterator stopped after iterating all nodes")
+)
+
+// Scapegoat is a self-balancing binary search tree that keeps no per-node balance metadata; when an
+// insert produces a node deeper than log base 1/alpha of the tree's size, the subtree rooted at an
+// alpha-unbalanced ancestor (the scapegoat) is rebuilt into perfect balance
+type Scapegoat[K any, V any] struct {
+	Tree    *Node[K, V]
+	alpha   float64
+	size    int
+	maxSize int
+	cmp     func(a, b K) int
+}
+
+// NewScapegoat constructs an empty Scapegoat with balance parameter alpha in [0.5, 1) ordered by the
+// natural ordering of its keys; smaller values of alpha keep the tree more strictly balanced at the
+// cost of more frequent rebuilds
+func NewScapegoat[K cmp.Ordered, V any](alpha float64) (*Scapegoat[K, V], error) {
+	return NewScapegoatFunc[K, V](alpha, cmp.Compare[K])
+}
+
+// NewScapegoatFunc constructs an empty Scapegoat with balance parameter alpha in [0.5, 1) ordered by
+// a comparison function that returns a negative number when a < b, a positive number when a > b,
+// and zero when a == b
+func NewScapegoatFunc[K any, V any](alpha float64, cmp func(a, b K) int) (*Scapegoat[K, V], error) {
+	if alpha < 0.5 || alpha >= 1 {
+		return nil, ErrInvalidAlpha
+	}
+	return &Scapegoat[K, V]{
+		alpha: alpha,
+		cmp:   cmp,
+	}, nil
+}
+
+// Node is a node of a Scapegoat indexed by Key containing value Val
+type Node[K any, V any] struct {
+	Key   K
+	Val   V
+	Left  *Node[K, V]
+	Right *Node[K, V]
+}
+
+// NewNode constructs a node
+func NewNode[K any, V any](key K, val V, left *Node[K, V], right *Node[K, V]) *Node[K, V] {
+	return &Node[K, V]{
+		Key:   key,
+		Val:   val,
+		Left:  left,
+		Right: right,
+	}
+}
+
+// Alpha returns the balance parameter of a Scapegoat
+func (s *Scapegoat[K, V]) Alpha() float64 {
+	return s.alpha
+}
+
+// Len returns the number of nodes in a Scapegoat
+func (s *Scapegoat[K, V]) Len() int {
+	return s.size
+}
+
+// IsEmpty evaluates if a Scapegoat is empty
+func (s *Scapegoat[K, V]) IsEmpty() bool {
+	return s.Tree == nil
+}
+
+// Insert inserts a key/value pair
+func (s *Scapegoat[K, V]) Insert(key K, val V) {
+	if s.IsEmpty() {
+		s.Tree = NewNode[K, V](key, val, nil, nil)
+		s.size, s.maxSize = 1, 1
+		return
+	}
+
+	// record the path from the root so that ancestors of the new node can be checked for balance
+	path := []*Node[K, V]{}
+	curTree := s.Tree
+	for {
+		path = append(path, curTree)
+		c := s.cmp(key, curTree.Key)
+		if c == 0 {
+			// allow an existing value to be overwritten
+			curTree.Val = val
+			return
+		}
+		if c < 0 {
+			if curTree.Left == nil {
+				curTree.Left = NewNode[K, V](key, val, nil, nil)
+				curTree = curTree.Left
+				break
+			}
+			curTree = curTree.Left
+			continue
+		}
+		if curTree.Right == nil {
+			curTree.Right = NewNode[K, V](key, val, nil, nil)
+			curTree = curTree.Right
+			break
+		}
+		curTree = curTree.Right
+	}
+
+	s.size++
+	s.maxSize = max(s.maxSize, s.size)
+	if len(path) <= s.heightBound(s.size) {
+		return
+	}
+
+	// walk back up the path to find an ancestor whose child on the path holds more than an alpha
+	// fraction of its nodes, which must exist because the new node is too deep
+	child, childSize := curTree, 1
+	for i := len(path) - 1; i >= 0; i-- {
+		ancestor := path[i]
+		sibling := ancestor.Left
+		if sibling == child {
+			sibling = ancestor.Right
+		}
+		ancestorSize := childSize + 1 + sibling.len()
+		if float64(childSize) > s.alpha*float64(ancestorSize) {
+			rebuilt := rebuild(ancestor, ancestorSize)
+			switch {
+			case i == 0:
+				s.Tree = rebuilt
+			case path[i-1].Left == ancestor:
+				path[i-1].Left = rebuilt
+			default:
+				path[i-1].Right = rebuilt
+			}
+			return
+		}
+		child, childSize = ancestor, ancestorSize
+	}
+}
+
+// Delete deletes a key/value pair
+func (s *Scapegoat[K, V]) Delete(key K) error {
+	if s.IsEmpty() {
+		return ErrEmpty
+	}
+
+	var parent *Node[K, V]
+	target := s.Tree
+	for {
+		c := s.cmp(key, target.Key)
+		if c == 0 {
+			break
+		}
+		parent = target
+		if c < 0 {
+			target = target.Left
+		} else {
+			target = target.Right
+		}
+		if target == nil {
+			return ErrKeyNotFound
+		}
+	}
+
+	if target.Left != nil && target.Right != nil {
+		// overwrite target with the leftmost (min) key/value of the right branch and remove that node
+		// instead, which has at most one child
+		left, leftParent := target.Right, target
+		for left.Left != nil {
+			leftParent, left = left, left.Left
+		}
+		target.Key, target.Val = left.Key, left.Val
+		target, parent = left, leftParent
+	}
+
+	child := target.Left
+	if child == nil {
+		child = target.Right
+	}
+	switch {
+	case parent == nil:
+		s.Tree = child
+	case parent.Left == target:
+		parent.Left = child
+	default:
+		parent.Right = child
+	}
+
+	s.size--
+	if float64(s.size) < s.alpha*float64(s.maxSize) {
+		s.Tree = rebuild(s.Tree, s.size)
+		s.maxSize = s.size
+	}
+	return nil
+}
+
+// Search searches a Scapegoat for a key
+func (s *Scapegoat[K, V]) Search(key K) (val V, found bool) {
+	curTree := s.Tree
+	for curTree != nil {
+		c := s.cmp(key, curTree.Key)
+		if c == 0 {
+			return curTree.Val, true
+		}
+		if c < 0 {
+			curTree = curTree.Left
+			continue
+		}
+		curTree = curTree.Right
+	}
+	return val, false
+}
+
+// Validate determines if a Scapegoat satisfies the binary search tree property
+func (s *Scapegoat[K, V]) Validate() (bool, error) {
+	if s.IsEmpty() {
+		return false, ErrEmpty
+	}
+
+	// a nil bound indicates that the subtree is unbounded on that side
+	type validationNode struct {
+		*Node[K, V]
+		minKey *K
+		maxKey *K
+	}
+
+	q := queue.NewQueue[*validationNode]()
+	q.Push(&validationNode{
+		Node: s.Tree,
+	})
+
+	for {
+		curNode, err := q.Pop()
+		if err == queue.ErrEmptyQueue {
+			return true, nil
+		}
+		if curNode.Node == nil {
+			continue
+		}
+		if curNode.minKey != nil && s.cmp(curNode.Key, *curNode.minKey) <= 0 {
+			return false, nil
+		}
+		if curNode.maxKey != nil && s.cmp(curNode.Key, *curNode.maxKey) >= 0 {
+			return false, nil
+		}
+
+		q.Push(&validationNode{
+			Node:   curNode.Left,
+			minKey: curNode.minKey,
+			maxKey: &curNode.Key,
+		})
+		q.Push(&validationNode{
+			Node:   curNode.Right,
+			minKey: &curNode.Key,
+			maxKey: curNode.maxKey,
+		})
+	}
+}
+
+// Iterator creates a function to iterate the nodes of the Scapegoat by returning the next (breadth-first) node on each call
+func (s *Scapegoat[K, V]) Iterator() func() (*Node[K, V], error) {
+	if s.IsEmpty() {
+		return func() (*Node[K, V], error) {
+			return nil, ErrIteratorStop
+		}
+	}
+
+	q := queue.NewQueue[*Node[K, V]]()
+	q.Push(s.Tree)
+	return func() (*Node[K, V], error) {
+		curS, err := q.Pop()
+		if err == queue.ErrEmptyQueue {
+			return nil, ErrIteratorStop
+		}
+		if curS.Left != nil {
+			q.Push(curS.Left)
+		}
+		if curS.Right != nil {
+			q.Push(curS.Right)
+		}
+		return curS, nil
+	}
+}
+
+// heightBound returns the maximum depth, counted in edges, allowed for a node of a tree of size n
+func (s *Scapegoat[K, V]) heightBound(n int) int {
+	return int(math.Floor(math.Log(float64(n)) / math.Log(1/s.alpha)))
+}
+
+// len counts the nodes of the subtree rooted at n
+func (n *Node[K, V]) len() int {
+	if n == nil {
+		return 0
+	}
+	return 1 + n.Left.len() + n.Right.len()
+}
+
+// rebuild rearranges the subtree rooted at n, which holds size nodes, into a perfectly balanced
+// subtree and returns its root
+func rebuild[K any, V any](n *Node[K, V], size int) *Node[K, V] {
+	nodes := make([]*Node[K, V], 0, size)
+	stack := []*Node[K, V]{}
+	curNode := n
+	for curNode != nil || len(stack) > 0 {
+		for curNode != nil {
+			stack = append(stack, curNode)
+			curNode = curNode.Left
+		}
+		curNode = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		nodes = append(nodes, curNode)
+		curNode = curNode.Right
+	}
+	return buildBalanced(nodes)
+}
+
+// buildBalanced links nodes, which are sorted by key, into a perfectly balanced subtree and returns its root
+func buildBalanced[K any, V any](nodes []*Node[K, V]) *Node[K, V] {
+	if len(nodes) == 0 {
+		return nil
+	}
+	mid := len(nodes) / 2
+	root := nodes[mid]
+	root.Left = buildBalanced(nodes[:mid])
+	root.Right = buildBalanced(nodes[mid+1:])
+	return root
+}
@@ -0,0 +1,342 @@
+package scapegoat
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testNode struct {
+	key int
+	val string
+}
+
+func newTestTree(t *testing.T, alpha float64, keys ...int) *Scapegoat[int, string] {
+	s, err := NewScapegoat[int, string](alpha)
+	assert.NoError(t, err)
+	for _, key := range keys {
+		s.Insert(key, valFor(key))
+	}
+	return s
+}
+
+func iterate(tree *Scapegoat[int, string]) []testNode {
+	nodes := []testNode{}
+	iter := tree.Iterator()
+	for {
+		node, err := iter()
+		if err == ErrIteratorStop {
+			return nodes
+		}
+		nodes = append(nodes, testNode{node.Key, node.Val})
+	}
+}
+
+func TestNewScapegoat(t *testing.T) {
+	tests := map[string]struct {
+		alpha       float64
+		expectedErr error
+	}{
+		"alpha of one half": {
+			alpha:       0.5,
+			expectedErr: nil,
+		},
+		"typical alpha": {
+			alpha:       0.7,
+			expectedErr: nil,
+		},
+		"alpha too small": {
+			alpha:       0.4,
+			expectedErr: ErrInvalidAlpha,
+		},
+		"alpha of one": {
+			alpha:       1,
+			expectedErr: ErrInvalidAlpha,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			s, err := NewScapegoat[int, string](test.alpha)
+			a.Equal(test.expectedErr, err)
+			if err != nil {
+				return
+			}
+			a.Equal(test.alpha, s.Alpha())
+			a.True(s.IsEmpty())
+		})
+	}
+}
+
+func TestInsert(t *testing.T) {
+	tests := map[string]struct {
+		alpha                 float64
+		insertKeys            []int
+		expectedIteratedNodes []testNode
+	}{
+		"single insert": {
+			alpha:                 0.5,
+			insertKeys:            []int{10},
+			expectedIteratedNodes: []testNode{{10, "val10"}},
+		},
+		"insert within depth bound does not rebuild": {
+			alpha:      0.5,
+			insertKeys: []int{20, 10, 30},
+			expectedIteratedNodes: []testNode{
+				{20, "val20"},
+				{10, "val10"},
+				{30, "val30"},
+			},
+		},
+		"insert beyond depth bound rebuilds scapegoat": {
+			alpha:      0.5,
+			insertKeys: []int{10, 20, 30},
+			expectedIteratedNodes: []testNode{
+				{20, "val20"},
+				{10, "val10"},
+				{30, "val30"},
+			},
+		},
+		"looser alpha tolerates deeper nodes": {
+			alpha:      0.75,
+			insertKeys: []int{10, 20, 30},
+			expectedIteratedNodes: []testNode{
+				{10, "val10"},
+				{20, "val20"},
+				{30, "val30"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			s := newTestTree(t, test.alpha, test.insertKeys...)
+			a.Equal(test.expectedIteratedNodes, iterate(s))
+			a.Equal(len(test.insertKeys), s.Len())
+
+			valid, err := s.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestInsertOverwrite(t *testing.T) {
+	a := assert.New(t)
+	s := newTestTree(t, 0.6, 10, 20)
+	s.Insert(10, "newVal10")
+	val, found := s.Search(10)
+	a.True(found)
+	a.Equal("newVal10", val)
+	a.Equal(2, s.Len())
+}
+
+func TestInsertSortedHeight(t *testing.T) {
+	for _, alpha := range []float64{0.5, 0.6, 0.75, 0.9} {
+		t.Run(fmt.Sprintf("alpha %v", alpha), func(t *testing.T) {
+			a := assert.New(t)
+			n := 1000
+			s := newTestTree(t, alpha)
+			for key := 0; key < n; key++ {
+				s.Insert(key, valFor(key))
+			}
+
+			valid, err := s.Validate()
+			a.NoError(err)
+			a.True(valid)
+
+			// no node is deeper than log base 1/alpha of the tree size
+			bound := math.Floor(math.Log(float64(n))/math.Log(1/alpha)) + 1
+			a.LessOrEqual(float64(height(s.Tree)), bound)
+		})
+	}
+}
+
+func TestSearch(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys     []int
+		searchKey      int
+		expectedExists bool
+	}{
+		"empty tree": {
+			insertKeys:     []int{},
+			searchKey:      1,
+			expectedExists: false,
+		},
+		"tree without searchKey": {
+			insertKeys:     []int{10, 8, 12},
+			searchKey:      2,
+			expectedExists: false,
+		},
+		"tree with searchKey": {
+			insertKeys:     []int{10, 8, 12},
+			searchKey:      12,
+			expectedExists: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			s := newTestTree(t, 0.6, test.insertKeys...)
+			val, exists := s.Search(test.searchKey)
+			a.Equal(test.expectedExists, exists)
+			if !test.expectedExists {
+				return
+			}
+			a.Equal(valFor(test.searchKey), val)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys            []int
+		deleteKey             int
+		expectedIteratedNodes []testNode
+		expectedErr           error
+	}{
+		"empty tree": {
+			insertKeys:  []int{},
+			deleteKey:   1,
+			expectedErr: ErrEmpty,
+		},
+		"tree without deleteKey": {
+			insertKeys:  []int{10, 8, 12},
+			deleteKey:   1,
+			expectedErr: ErrKeyNotFound,
+		},
+		"single node tree": {
+			insertKeys:            []int{10},
+			deleteKey:             10,
+			expectedIteratedNodes: []testNode{},
+		},
+		"delete leaf": {
+			insertKeys: []int{20, 10, 30, 25},
+			deleteKey:  25,
+			expectedIteratedNodes: []testNode{
+				{20, "val20"},
+				{10, "val10"},
+				{30, "val30"},
+			},
+		},
+		"delete node with two children": {
+			insertKeys: []int{20, 10, 30, 25, 40},
+			deleteKey:  30,
+			expectedIteratedNodes: []testNode{
+				{20, "val20"},
+				{10, "val10"},
+				{40, "val40"},
+				{25, "val25"},
+			},
+		},
+		"delete shrinking below alpha of max size rebuilds tree": {
+			insertKeys: []int{20, 10, 30, 40},
+			deleteKey:  10,
+			expectedIteratedNodes: []testNode{
+				{30, "val30"},
+				{20, "val20"},
+				{40, "val40"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			s := newTestTree(t, 0.8, test.insertKeys...)
+			err := s.Delete(test.deleteKey)
+			if test.expectedErr != nil {
+				a.Equal(test.expectedErr, err)
+				return
+			}
+			a.NoError(err)
+			a.Equal(test.expectedIteratedNodes, iterate(s))
+			a.Equal(len(test.expectedIteratedNodes), s.Len())
+
+			if s.IsEmpty() {
+				return
+			}
+			valid, err := s.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestRandomInsertDelete(t *testing.T) {
+	a := assert.New(t)
+	r := rand.New(rand.NewSource(1))
+	n := 1000
+	s := newTestTree(t, 0.7, r.Perm(n)...)
+
+	deleted := map[int]bool{}
+	for _, key := range r.Perm(n)[:n/2] {
+		a.NoError(s.Delete(key))
+		deleted[key] = true
+	}
+	valid, err := s.Validate()
+	a.NoError(err)
+	a.True(valid)
+	a.Equal(n/2, s.Len())
+
+	for key := 0; key < n; key++ {
+		_, found := s.Search(key)
+		a.Equal(!deleted[key], found)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		tree          *Node[int, string]
+		expectedValid bool
+		expectedErr   error
+	}{
+		"empty tree": {
+			tree:          nil,
+			expectedValid: false,
+			expectedErr:   ErrEmpty,
+		},
+		"valid tree": {
+			tree: NewNode(10, "val10",
+				NewNode(8, "val8", nil, nil),
+				NewNode(12, "val12", nil, nil),
+			),
+			expectedValid: true,
+		},
+		"invalid tree": {
+			tree: NewNode(10, "val10",
+				NewNode(11, "val11", nil, nil),
+				NewNode(12, "val12", nil, nil),
+			),
+			expectedValid: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			s := newTestTree(t, 0.6)
+			s.Tree = test.tree
+			valid, err := s.Validate()
+			a.Equal(test.expectedValid, valid)
+			a.Equal(test.expectedErr, err)
+		})
+	}
+}
+
+func height(n *Node[int, string]) int {
+	if n == nil {
+		return 0
+	}
+	return max(height(n.Left), height(n.Right)) + 1
+}
+
+func valFor(key int) string {
+	return fmt.Sprintf("val%d", key)
+}
@@ -0,0 +1,25 @@
+package skiplist
+
+import "math/rand"
+
+// Option configures a SkipList at construction
+type Option func(*options)
+
+type options struct {
+	r *rand.Rand
+}
+
+// WithSeed seeds the source of randomness used to draw node levels, making the shape of a SkipList
+// reproducible
+func WithSeed(seed int64) Option {
+	return func(o *options) {
+		o.r = rand.New(rand.NewSource(seed))
+	}
+}
+
+// WithRand sets the source of randomness used to draw node levels
+func WithRand(r *rand.Rand) Option {
+	return func(o *options) {
+		o.r = r
+	}
+}
@@ -0,0 +1,244 @@
+package skiplist
+
+import (
+	"cmp"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// Errors returned from a SkipList
+var (
+	ErrEmpty              error = errors.New("SkipList is empty")
+	ErrKeyNotFound        error = errors.New("key not found in SkipList")
+	ErrInvalidMaxLevel    error = errors.New("max level of SkipList must be at least 1")
+	ErrInvalidProbability error = errors.New("promotion probability of SkipList must be in the interval (0, 1)")
+	ErrIteratorStop       error = errors.New("iterator stopped after iterating all nodes")
+)
+
+// SkipList is a probabilistic ordered map made of a hierarchy of sorted linked lists, in which each
+// node of a list is promoted to the list above it with a fixed probability
+type SkipList[K any, V any] struct {
+	head     *Node[K, V]
+	level    int
+	maxLevel int
+	p        float64
+	cmp      func(a, b K) int
+	r        *rand.Rand
+}
+
+// NewSkipList constructs an empty SkipList ordered by the natural ordering of its keys, with nodes
+// spanning at most maxLevel lists and promoted to each higher list with probability p
+func NewSkipList[K cmp.Ordered, V any](maxLevel int, p float64, opts ...Option) (*SkipList[K, V], error) {
+	return NewSkipListFunc[K, V](maxLevel, p, cmp.Compare[K], opts...)
+}
+
+// NewSkipListFunc constructs an empty SkipList ordered by a comparison function that returns a
+// negative number when a < b, a positive number when a > b, and zero when a == b, with nodes
+// spanning at most maxLevel lists and promoted to each higher list with probability p
+func NewSkipListFunc[K any, V any](maxLevel int, p float64, cmp func(a, b K) int, opts ...Option) (*SkipList[K, V], error) {
+	if maxLevel < 1 {
+		return nil, ErrInvalidMaxLevel
+	}
+	if p <= 0 || p >= 1 {
+		return nil, ErrInvalidProbability
+	}
+
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.r == nil {
+		o.r = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	return &SkipList[K, V]{
+		head:     &Node[K, V]{next: make([]*Node[K, V], maxLevel)},
+		level:    1,
+		maxLevel: maxLevel,
+		p:        p,
+		cmp:      cmp,
+		r:        o.r,
+	}, nil
+}
+
+// Node is a node of a SkipList indexed by Key containing value Val
+type Node[K any, V any] struct {
+	Key  K
+	Val  V
+	next []*Node[K, V]
+}
+
+// Next returns the node with the next larger key, or nil for the last node
+func (n *Node[K, V]) Next() *Node[K, V] {
+	return n.next[0]
+}
+
+// Level returns the number of lists a node belongs to
+func (n *Node[K, V]) Level() int {
+	return len(n.next)
+}
+
+// IsEmpty evaluates if a SkipList is empty
+func (s *SkipList[K, V]) IsEmpty() bool {
+	return s.head.next[0] == nil
+}
+
+// First returns the node with the smallest key, or nil if the SkipList is empty
+func (s *SkipList[K, V]) First() *Node[K, V] {
+	return s.head.next[0]
+}
+
+// Insert inserts a key/value pair
+func (s *SkipList[K, V]) Insert(key K, val V) {
+	update := s.findPredecessors(key)
+	if next := update[0].next[0]; next != nil && s.cmp(key, next.Key) == 0 {
+		// allow an existing value to be overwritten
+		next.Val = val
+		return
+	}
+
+	level := s.randomLevel()
+	if level > s.level {
+		for i := s.level; i < level; i++ {
+			update[i] = s.head
+		}
+		s.level = level
+	}
+
+	node := &Node[K, V]{
+		Key:  key,
+		Val:  val,
+		next: make([]*Node[K, V], level),
+	}
+	for i := 0; i < level; i++ {
+		node.next[i] = update[i].next[i]
+		update[i].next[i] = node
+	}
+}
+
+// Delete deletes a key/value pair
+func (s *SkipList[K, V]) Delete(key K) error {
+	if s.IsEmpty() {
+		return ErrEmpty
+	}
+
+	update := s.findPredecessors(key)
+	target := update[0].next[0]
+	if target == nil || s.cmp(key, target.Key) != 0 {
+		return ErrKeyNotFound
+	}
+
+	for i := 0; i < len(target.next); i++ {
+		update[i].next[i] = target.next[i]
+	}
+	// drop lists that have been emptied
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	return nil
+}
+
+// Search searches a SkipList for a key
+func (s *SkipList[K, V]) Search(key K) (val V, found bool) {
+	curNode := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for curNode.next[i] != nil && s.cmp(curNode.next[i].Key, key) < 0 {
+			curNode = curNode.next[i]
+		}
+	}
+	curNode = curNode.next[0]
+	if curNode == nil || s.cmp(key, curNode.Key) != 0 {
+		return val, false
+	}
+	return curNode.Val, true
+}
+
+// Range creates a function to iterate, in ascending key order, the nodes with keys between lo and hi
+// inclusive by returning the next node on each call
+func (s *SkipList[K, V]) Range(lo K, hi K) func() (*Node[K, V], error) {
+	curNode := s.findPredecessors(lo)[0].next[0]
+	return func() (*Node[K, V], error) {
+		if curNode == nil || s.cmp(curNode.Key, hi) > 0 {
+			return nil, ErrIteratorStop
+		}
+		node := curNode
+		curNode = curNode.next[0]
+		return node, nil
+	}
+}
+
+// Validate determines if every list of a SkipList is sorted and is a subsequence of the list below it
+func (s *SkipList[K, V]) Validate() (bool, error) {
+	if s.IsEmpty() {
+		return false, ErrEmpty
+	}
+
+	// record the position of each node in the bottom list and the number of nodes that should be
+	// linked into each higher list
+	position := map[*Node[K, V]]int{}
+	counts := make([]int, s.maxLevel)
+	for curNode, i := s.head.next[0], 0; curNode != nil; curNode, i = curNode.next[0], i+1 {
+		if len(curNode.next) < 1 || len(curNode.next) > s.maxLevel {
+			return false, nil
+		}
+		if next := curNode.next[0]; next != nil && s.cmp(curNode.Key, next.Key) >= 0 {
+			return false, nil
+		}
+		position[curNode] = i
+		for level := range curNode.next {
+			counts[level]++
+		}
+	}
+
+	for level := 1; level < s.maxLevel; level++ {
+		prev, count := -1, 0
+		for curNode := s.head.next[level]; curNode != nil; curNode = curNode.next[level] {
+			pos, ok := position[curNode]
+			if !ok || pos <= prev || level >= len(curNode.next) {
+				return false, nil
+			}
+			prev = pos
+			count++
+		}
+		if count != counts[level] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Iterator creates a function to iterate the nodes of the SkipList by returning the next (ascending key order) node on each call
+func (s *SkipList[K, V]) Iterator() func() (*Node[K, V], error) {
+	curNode := s.head.next[0]
+	return func() (*Node[K, V], error) {
+		if curNode == nil {
+			return nil, ErrIteratorStop
+		}
+		node := curNode
+		curNode = curNode.next[0]
+		return node, nil
+	}
+}
+
+// findPredecessors returns, for each level, the last node with key less than key
+func (s *SkipList[K, V]) findPredecessors(key K) []*Node[K, V] {
+	update := make([]*Node[K, V], s.maxLevel)
+	curNode := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for curNode.next[i] != nil && s.cmp(curNode.next[i].Key, key) < 0 {
+			curNode = curNode.next[i]
+		}
+		update[i] = curNode
+	}
+	return update
+}
+
+// randomLevel draws the number of lists for a new node from a geometric distribution with parameter p
+func (s *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < s.maxLevel && s.r.Float64() < s.p {
+		level++
+	}
+	return level
+}
@@ -0,0 +1,353 @@
+package skiplist
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newSeededSkipList constructs a SkipList with a fixed seed so that node levels are deterministic
+func newSeededSkipList(t *testing.T, keys ...int) *SkipList[int, string] {
+	s, err := NewSkipList[int, string](8, 0.5, WithSeed(1))
+	assert.NoError(t, err)
+	for _, key := range keys {
+		s.Insert(key, valFor(key))
+	}
+	return s
+}
+
+func collect(next func() (*Node[int, string], error)) []int {
+	keys := []int{}
+	for {
+		node, err := next()
+		if err == ErrIteratorStop {
+			return keys
+		}
+		keys = append(keys, node.Key)
+	}
+}
+
+func TestNewSkipList(t *testing.T) {
+	tests := map[string]struct {
+		maxLevel    int
+		p           float64
+		expectedErr error
+	}{
+		"valid parameters": {
+			maxLevel:    16,
+			p:           0.25,
+			expectedErr: nil,
+		},
+		"single level": {
+			maxLevel:    1,
+			p:           0.5,
+			expectedErr: nil,
+		},
+		"max level too small": {
+			maxLevel:    0,
+			p:           0.5,
+			expectedErr: ErrInvalidMaxLevel,
+		},
+		"probability too small": {
+			maxLevel:    16,
+			p:           0,
+			expectedErr: ErrInvalidProbability,
+		},
+		"probability too large": {
+			maxLevel:    16,
+			p:           1,
+			expectedErr: ErrInvalidProbability,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			s, err := NewSkipList[int, string](test.maxLevel, test.p)
+			a.Equal(test.expectedErr, err)
+			if err != nil {
+				return
+			}
+			a.True(s.IsEmpty())
+		})
+	}
+}
+
+func TestInsert(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys   []int
+		expectedKeys []int
+	}{
+		"single insert": {
+			insertKeys:   []int{10},
+			expectedKeys: []int{10},
+		},
+		"sorted inserts": {
+			insertKeys:   []int{1, 2, 3, 4, 5, 6, 7, 8},
+			expectedKeys: []int{1, 2, 3, 4, 5, 6, 7, 8},
+		},
+		"unsorted inserts": {
+			insertKeys:   []int{20, 10, 30, 25, 40, 15},
+			expectedKeys: []int{10, 15, 20, 25, 30, 40},
+		},
+		"duplicate inserts": {
+			insertKeys:   []int{20, 10, 20, 10},
+			expectedKeys: []int{10, 20},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			s := newSeededSkipList(t, test.insertKeys...)
+			a.Equal(test.expectedKeys, collect(s.Iterator()))
+
+			valid, err := s.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestInsertOverwrite(t *testing.T) {
+	a := assert.New(t)
+	s := newSeededSkipList(t, 10, 20)
+	s.Insert(10, "newVal10")
+	val, found := s.Search(10)
+	a.True(found)
+	a.Equal("newVal10", val)
+}
+
+func TestDeterministicLevels(t *testing.T) {
+	levels := func() []int {
+		s := newSeededSkipList(t, 50, 10, 40, 20, 30)
+		result := []int{}
+		for n := s.First(); n != nil; n = n.Next() {
+			result = append(result, n.Level())
+		}
+		return result
+	}
+	assert.Equal(t, levels(), levels())
+}
+
+func TestSeedOptions(t *testing.T) {
+	levels := func(opts ...Option) []int {
+		s, err := NewSkipList[int, string](8, 0.5, opts...)
+		assert.NoError(t, err)
+		for key := 0; key < 100; key++ {
+			s.Insert(key, valFor(key))
+		}
+		result := []int{}
+		for n := s.First(); n != nil; n = n.Next() {
+			result = append(result, n.Level())
+		}
+		return result
+	}
+
+	a := assert.New(t)
+	expected := levels(WithSeed(7))
+	a.Equal(expected, levels(WithSeed(7)))
+	a.Equal(expected, levels(WithRand(rand.New(rand.NewSource(7)))))
+	a.NotEqual(expected, levels(WithSeed(8)))
+}
+
+func TestSearch(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys     []int
+		searchKey      int
+		expectedExists bool
+	}{
+		"empty list": {
+			insertKeys:     []int{},
+			searchKey:      1,
+			expectedExists: false,
+		},
+		"list without searchKey": {
+			insertKeys:     []int{10, 8, 12},
+			searchKey:      2,
+			expectedExists: false,
+		},
+		"list with searchKey": {
+			insertKeys:     []int{10, 8, 12},
+			searchKey:      12,
+			expectedExists: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			s := newSeededSkipList(t, test.insertKeys...)
+			val, exists := s.Search(test.searchKey)
+			a.Equal(test.expectedExists, exists)
+			if !test.expectedExists {
+				return
+			}
+			a.Equal(valFor(test.searchKey), val)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys   []int
+		deleteKey    int
+		expectedKeys []int
+		expectedErr  error
+	}{
+		"empty list": {
+			insertKeys:  []int{},
+			deleteKey:   1,
+			expectedErr: ErrEmpty,
+		},
+		"list without deleteKey": {
+			insertKeys:  []int{10, 8, 12},
+			deleteKey:   1,
+			expectedErr: ErrKeyNotFound,
+		},
+		"single node list": {
+			insertKeys:   []int{10},
+			deleteKey:    10,
+			expectedKeys: []int{},
+		},
+		"delete first": {
+			insertKeys:   []int{20, 10, 30, 25, 40, 15},
+			deleteKey:    10,
+			expectedKeys: []int{15, 20, 25, 30, 40},
+		},
+		"delete middle": {
+			insertKeys:   []int{20, 10, 30, 25, 40, 15},
+			deleteKey:    25,
+			expectedKeys: []int{10, 15, 20, 30, 40},
+		},
+		"delete last": {
+			insertKeys:   []int{20, 10, 30, 25, 40, 15},
+			deleteKey:    40,
+			expectedKeys: []int{10, 15, 20, 25, 30},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			s := newSeededSkipList(t, test.insertKeys...)
+			err := s.Delete(test.deleteKey)
+			if test.expectedErr != nil {
+				a.Equal(test.expectedErr, err)
+				return
+			}
+			a.NoError(err)
+			a.Equal(test.expectedKeys, collect(s.Iterator()))
+
+			if s.IsEmpty() {
+				return
+			}
+			valid, err := s.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestRandomInsertDelete(t *testing.T) {
+	a := assert.New(t)
+	r := rand.New(rand.NewSource(1))
+	n := 1000
+	s := newSeededSkipList(t, r.Perm(n)...)
+
+	deleted := map[int]bool{}
+	for _, key := range r.Perm(n)[:n/2] {
+		a.NoError(s.Delete(key))
+		deleted[key] = true
+	}
+	valid, err := s.Validate()
+	a.NoError(err)
+	a.True(valid)
+
+	for key := 0; key < n; key++ {
+		_, found := s.Search(key)
+		a.Equal(!deleted[key], found)
+	}
+}
+
+func TestRange(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys   []int
+		lo           int
+		hi           int
+		expectedKeys []int
+	}{
+		"empty list": {
+			insertKeys:   []int{},
+			lo:           1,
+			hi:           10,
+			expectedKeys: []int{},
+		},
+		"full range": {
+			insertKeys:   []int{50, 10, 40, 20, 30},
+			lo:           0,
+			hi:           100,
+			expectedKeys: []int{10, 20, 30, 40, 50},
+		},
+		"bounds on existing keys are inclusive": {
+			insertKeys:   []int{50, 10, 40, 20, 30},
+			lo:           20,
+			hi:           40,
+			expectedKeys: []int{20, 30, 40},
+		},
+		"bounds between keys": {
+			insertKeys:   []int{50, 10, 40, 20, 30},
+			lo:           15,
+			hi:           45,
+			expectedKeys: []int{20, 30, 40},
+		},
+		"empty range": {
+			insertKeys:   []int{50, 10, 40, 20, 30},
+			lo:           41,
+			hi:           49,
+			expectedKeys: []int{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := newSeededSkipList(t, test.insertKeys...)
+			assert.Equal(t, test.expectedKeys, collect(s.Range(test.lo, test.hi)))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("empty list", func(t *testing.T) {
+		a := assert.New(t)
+		valid, err := newSeededSkipList(t).Validate()
+		a.False(valid)
+		a.Equal(ErrEmpty, err)
+	})
+
+	t.Run("bottom list out of order", func(t *testing.T) {
+		a := assert.New(t)
+		s := newSeededSkipList(t, 10, 20, 30)
+		s.First().Key = 25
+		valid, err := s.Validate()
+		a.NoError(err)
+		a.False(valid)
+	})
+
+	t.Run("node missing from higher list", func(t *testing.T) {
+		a := assert.New(t)
+		s := newSeededSkipList(t, 10, 20, 30)
+		// promote the first node without linking it into the level above
+		first := s.First()
+		first.next = append(first.next, nil)
+		valid, err := s.Validate()
+		a.NoError(err)
+		a.False(valid)
+	})
+}
+
+func valFor(key int) string {
+	return fmt.Sprintf("val%d", key)
+}
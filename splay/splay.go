@@ -0,0 +1,260 @@
+package splay
+
+import (
+	"cmp"
+	"errors"
+
+	"github.com/dkaslovsky/search-structures/queue"
+)
+
+// Errors returned from a Splay
+var (
+	ErrEmpty        error = errors.New("Splay is empty")
+	ErrKeyNotFound  error = errors.New("key not found in Splay")
+	ErrIteratorStop error = errors.New("iterator stopped after iterating all nodes")
+)
+
+// Splay is a self-adjusting binary search tree that moves each accessed node to the root, so that
+// frequently accessed keys are found near the top of the tree
+type Splay[K any, V any] struct {
+	Tree *Node[K, V]
+	cmp  func(a, b K) int
+}
+
+// NewSplay constructs a Splay ordered by the natural ordering of its keys
+func NewSplay[K cmp.Ordered, V any](tree *Node[K, V]) *Splay[K, V] {
+	return NewSplayFunc(tree, cmp.Compare[K])
+}
+
+// NewSplayFunc constructs a Splay ordered by a comparison function that returns a negative number
+// when a < b, a positive number when a > b, and zero when a == b
+func NewSplayFunc[K any, V any](tree *Node[K, V], cmp func(a, b K) int) *Splay[K, V] {
+	return &Splay[K, V]{
+		Tree: tree,
+		cmp:  cmp,
+	}
+}
+
+// Node is a node of a Splay indexed by Key containing value Val
+type Node[K any, V any] struct {
+	Key   K
+	Val   V
+	Left  *Node[K, V]
+	Right *Node[K, V]
+}
+
+// NewNode constructs a node
+func NewNode[K any, V any](key K, val V, left *Node[K, V], right *Node[K, V]) *Node[K, V] {
+	return &Node[K, V]{
+		Key:   key,
+		Val:   val,
+		Left:  left,
+		Right: right,
+	}
+}
+
+// IsEmpty evaluates if a Splay is empty
+func (s *Splay[K, V]) IsEmpty() bool {
+	return s.Tree == nil
+}
+
+// Insert inserts a key/value pair and moves it to the root
+func (s *Splay[K, V]) Insert(key K, val V) {
+	if s.IsEmpty() {
+		s.Tree = NewNode[K, V](key, val, nil, nil)
+		return
+	}
+
+	root := s.splay(s.Tree, key)
+	c := s.cmp(key, root.Key)
+	if c == 0 {
+		// allow an existing value to be overwritten
+		root.Val = val
+		s.Tree = root
+		return
+	}
+
+	// the splayed root is the closest key to the inserted key, so its subtrees are divided between
+	// the new root's children
+	if c < 0 {
+		s.Tree = NewNode(key, val, root.Left, root)
+		root.Left = nil
+		return
+	}
+	s.Tree = NewNode(key, val, root, root.Right)
+	root.Right = nil
+}
+
+// Delete deletes a key/value pair, moving the nearest remaining key to the root
+func (s *Splay[K, V]) Delete(key K) error {
+	if s.IsEmpty() {
+		return ErrEmpty
+	}
+
+	s.Tree = s.splay(s.Tree, key)
+	if s.cmp(key, s.Tree.Key) != 0 {
+		return ErrKeyNotFound
+	}
+
+	if s.Tree.Left == nil {
+		s.Tree = s.Tree.Right
+		return nil
+	}
+	// every key on the left is less than key, so splaying for key brings the rightmost (max) node
+	// of the left branch to its root, leaving its right child empty to receive the right branch
+	right := s.Tree.Right
+	s.Tree = s.splay(s.Tree.Left, key)
+	s.Tree.Right = right
+	return nil
+}
+
+// Search searches a Splay for a key, moving the key (or the last node visited if the key is not
+// found) to the root
+func (s *Splay[K, V]) Search(key K) (val V, found bool) {
+	if s.IsEmpty() {
+		return val, false
+	}
+
+	s.Tree = s.splay(s.Tree, key)
+	if s.cmp(key, s.Tree.Key) != 0 {
+		return val, false
+	}
+	return s.Tree.Val, true
+}
+
+// Validate determines if a Splay satisfies the binary search tree property
+func (s *Splay[K, V]) Validate() (bool, error) {
+	if s.IsEmpty() {
+		return false, ErrEmpty
+	}
+
+	// a nil bound indicates that the subtree is unbounded on that side
+	type validationNode struct {
+		*Node[K, V]
+		minKey *K
+		maxKey *K
+	}
+
+	q := queue.NewQueue[*validationNode]()
+	q.Push(&validationNode{
+		Node: s.Tree,
+	})
+
+	for {
+		curNode, err := q.Pop()
+		if err == queue.ErrEmptyQueue {
+			return true, nil
+		}
+		if curNode.Node == nil {
+			continue
+		}
+		if curNode.minKey != nil && s.cmp(curNode.Key, *curNode.minKey) <= 0 {
+			return false, nil
+		}
+		if curNode.maxKey != nil && s.cmp(curNode.Key, *curNode.maxKey) >= 0 {
+			return false, nil
+		}
+
+		q.Push(&validationNode{
+			Node:   curNode.Left,
+			minKey: curNode.minKey,
+			maxKey: &curNode.Key,
+		})
+		q.Push(&validationNode{
+			Node:   curNode.Right,
+			minKey: &curNode.Key,
+			maxKey: curNode.maxKey,
+		})
+	}
+}
+
+// Iterator creates a function to iterate the nodes of the Splay by returning the next (breadth-first) node on each call
+func (s *Splay[K, V]) Iterator() func() (*Node[K, V], error) {
+	if s.IsEmpty() {
+		return func() (*Node[K, V], error) {
+			return nil, ErrIteratorStop
+		}
+	}
+
+	q := queue.NewQueue[*Node[K, V]]()
+	q.Push(s.Tree)
+	return func() (*Node[K, V], error) {
+		curS, err := q.Pop()
+		if err == queue.ErrEmptyQueue {
+			return nil, ErrIteratorStop
+		}
+		if curS.Left != nil {
+			q.Push(curS.Left)
+		}
+		if curS.Right != nil {
+			q.Push(curS.Right)
+		}
+		return curS, nil
+	}
+}
+
+// splay performs a top-down splay of the subtree rooted at n, returning a subtree rooted at the node
+// with key or, if key is not present, at the last node visited while searching for it
+func (s *Splay[K, V]) splay(n *Node[K, V], key K) *Node[K, V] {
+	// nodes greater than key are collected on the left of header and nodes less than key on the right
+	header := &Node[K, V]{}
+	left, right := header, header
+
+	curTree := n
+	for {
+		c := s.cmp(key, curTree.Key)
+		if c == 0 {
+			break
+		}
+		if c < 0 {
+			if curTree.Left == nil {
+				break
+			}
+			// zig-zig: rotate right before linking
+			if s.cmp(key, curTree.Left.Key) < 0 {
+				curTree = curTree.rotateRight()
+				if curTree.Left == nil {
+					break
+				}
+			}
+			right.Left = curTree
+			right = curTree
+			curTree = curTree.Left
+			continue
+		}
+		if curTree.Right == nil {
+			break
+		}
+		// zag-zag: rotate left before linking
+		if s.cmp(key, curTree.Right.Key) > 0 {
+			curTree = curTree.rotateLeft()
+			if curTree.Right == nil {
+				break
+			}
+		}
+		left.Right = curTree
+		left = curTree
+		curTree = curTree.Right
+	}
+
+	// reassemble the collected left and right trees around the new root
+	left.Right = curTree.Left
+	right.Left = curTree.Right
+	curTree.Left = header.Right
+	curTree.Right = header.Left
+	return curTree
+}
+
+func (n *Node[K, V]) rotateLeft() *Node[K, V] {
+	right := n.Right
+	n.Right = right.Left
+	right.Left = n
+	return right
+}
+
+func (n *Node[K, V]) rotateRight() *Node[K, V] {
+	left := n.Left
+	n.Left = left.Right
+	left.Right = n
+	return left
+}
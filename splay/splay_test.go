@@ -0,0 +1,311 @@
+package splay
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dkaslovsky/search-structures/bst"
+	"github.com/stretchr/testify/assert"
+)
+
+type testNode struct {
+	key int
+	val string
+}
+
+func iterate(tree *Splay[int, string]) []testNode {
+	nodes := []testNode{}
+	iter := tree.Iterator()
+	for {
+		node, err := iter()
+		if err == ErrIteratorStop {
+			return nodes
+		}
+		nodes = append(nodes, testNode{node.Key, node.Val})
+	}
+}
+
+// newTestTree constructs the tree
+//
+//	     20
+//	    /  \
+//	  10    30
+//	 /  \     \
+//	5   15     40
+func newTestTree() *Splay[int, string] {
+	return NewSplay(
+		NewNode(20, "val20",
+			NewNode(10, "val10",
+				NewNode(5, "val5", nil, nil),
+				NewNode(15, "val15", nil, nil),
+			),
+			NewNode(30, "val30",
+				nil,
+				NewNode(40, "val40", nil, nil),
+			),
+		),
+	)
+}
+
+func TestInsert(t *testing.T) {
+	tests := map[string]struct {
+		tree                  *Splay[int, string]
+		insertKey             int
+		insertVal             string
+		expectedIteratedNodes []testNode
+	}{
+		"insert into empty tree": {
+			tree:                  NewSplay[int, string](nil),
+			insertKey:             10,
+			insertVal:             "val10",
+			expectedIteratedNodes: []testNode{{10, "val10"}},
+		},
+		"insert moves new key to root": {
+			tree:      newTestTree(),
+			insertKey: 12,
+			insertVal: "val12",
+			expectedIteratedNodes: []testNode{
+				{12, "val12"},
+				{10, "val10"},
+				{15, "val15"},
+				{5, "val5"},
+				{20, "val20"},
+				{30, "val30"},
+				{40, "val40"},
+			},
+		},
+		"insert of existing key overwrites value and moves it to root": {
+			tree:      newTestTree(),
+			insertKey: 30,
+			insertVal: "newVal30",
+			expectedIteratedNodes: []testNode{
+				{30, "newVal30"},
+				{20, "val20"},
+				{40, "val40"},
+				{10, "val10"},
+				{5, "val5"},
+				{15, "val15"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			test.tree.Insert(test.insertKey, test.insertVal)
+			a.Equal(test.expectedIteratedNodes, iterate(test.tree))
+
+			valid, err := test.tree.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestSearch(t *testing.T) {
+	tests := map[string]struct {
+		tree            *Splay[int, string]
+		searchKey       int
+		expectedValue   string
+		expectedExists  bool
+		expectedRootKey int
+	}{
+		"empty tree": {
+			tree:           NewSplay[int, string](nil),
+			searchKey:      1,
+			expectedExists: false,
+		},
+		"zig-zig search moves key to root": {
+			tree:            newTestTree(),
+			searchKey:       5,
+			expectedValue:   "val5",
+			expectedExists:  true,
+			expectedRootKey: 5,
+		},
+		"zig-zag search moves key to root": {
+			tree:            newTestTree(),
+			searchKey:       15,
+			expectedValue:   "val15",
+			expectedExists:  true,
+			expectedRootKey: 15,
+		},
+		"search for missing key moves last visited node to root": {
+			tree:            newTestTree(),
+			searchKey:       35,
+			expectedExists:  false,
+			expectedRootKey: 40,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			val, exists := test.tree.Search(test.searchKey)
+			a.Equal(test.expectedExists, exists)
+			a.Equal(test.expectedValue, val)
+			if test.tree.IsEmpty() {
+				return
+			}
+			a.Equal(test.expectedRootKey, test.tree.Tree.Key)
+
+			valid, err := test.tree.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := map[string]struct {
+		tree         *Splay[int, string]
+		deleteKey    int
+		expectedKeys []int
+		expectedErr  error
+	}{
+		"empty tree": {
+			tree:        NewSplay[int, string](nil),
+			deleteKey:   1,
+			expectedErr: ErrEmpty,
+		},
+		"tree without deleteKey": {
+			tree:        newTestTree(),
+			deleteKey:   1,
+			expectedErr: ErrKeyNotFound,
+		},
+		"single node tree": {
+			tree:         NewSplay(NewNode(10, "val10", nil, nil)),
+			deleteKey:    10,
+			expectedKeys: []int{},
+		},
+		"delete root": {
+			tree:         newTestTree(),
+			deleteKey:    20,
+			expectedKeys: []int{5, 10, 15, 30, 40},
+		},
+		"delete leaf": {
+			tree:         newTestTree(),
+			deleteKey:    40,
+			expectedKeys: []int{5, 10, 15, 20, 30},
+		},
+		"delete min": {
+			tree:         newTestTree(),
+			deleteKey:    5,
+			expectedKeys: []int{10, 15, 20, 30, 40},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			err := test.tree.Delete(test.deleteKey)
+			if test.expectedErr != nil {
+				a.Equal(test.expectedErr, err)
+				return
+			}
+			a.NoError(err)
+			a.Equal(test.expectedKeys, inOrderKeys(test.tree.Tree))
+
+			if test.tree.IsEmpty() {
+				return
+			}
+			valid, err := test.tree.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		tree          *Splay[int, string]
+		expectedValid bool
+		expectedErr   error
+	}{
+		"empty tree": {
+			tree:          NewSplay[int, string](nil),
+			expectedValid: false,
+			expectedErr:   ErrEmpty,
+		},
+		"valid tree": {
+			tree:          newTestTree(),
+			expectedValid: true,
+		},
+		"invalid tree": {
+			tree: NewSplay(
+				NewNode(10, "val10",
+					NewNode(11, "val11", nil, nil),
+					nil,
+				),
+			),
+			expectedValid: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			valid, err := test.tree.Validate()
+			a.Equal(test.expectedValid, valid)
+			a.Equal(test.expectedErr, err)
+		})
+	}
+}
+
+func inOrderKeys(n *Node[int, string]) []int {
+	if n == nil {
+		return []int{}
+	}
+	keys := append(inOrderKeys(n.Left), n.Key)
+	return append(keys, inOrderKeys(n.Right)...)
+}
+
+const (
+	benchmarkKeys    = 1 << 16
+	benchmarkZipfS   = 1.2
+	benchmarkZipfV   = 1
+	benchmarkLookups = 1 << 12
+)
+
+// zipfKeys generates a stream of keys in which a small set of hot keys, scattered randomly through
+// the key space, is accessed far more often than the rest
+func zipfKeys(r *rand.Rand) []int {
+	perm := r.Perm(benchmarkKeys)
+	zipf := rand.NewZipf(r, benchmarkZipfS, benchmarkZipfV, benchmarkKeys-1)
+	keys := make([]int, benchmarkLookups)
+	for i := range keys {
+		keys[i] = perm[zipf.Uint64()]
+	}
+	return keys
+}
+
+func BenchmarkSearchZipf(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	insertKeys := r.Perm(benchmarkKeys)
+	lookupKeys := zipfKeys(r)
+
+	b.Run("Splay", func(b *testing.B) {
+		tree := NewSplay[int, string](nil)
+		for _, key := range insertKeys {
+			tree.Insert(key, "")
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, key := range lookupKeys {
+				tree.Search(key)
+			}
+		}
+	})
+
+	b.Run("Bst", func(b *testing.B) {
+		tree := bst.NewBst[int, string](nil)
+		for _, key := range insertKeys {
+			tree.Insert(key, "")
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, key := range lookupKeys {
+				tree.Search(key)
+			}
+		}
+	})
+}
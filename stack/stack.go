@@ -0,0 +1,62 @@
+package stack
+
+import "errors"
+
+// Errors returned from a Stack
+var (
+	ErrEmptyStack = errors.New("cannot pop from empty stack")
+)
+
+// Stack is a last-in-first-out stack of items of type T
+type Stack[T any] struct {
+	data []T
+}
+
+// NewStack constructs a Stack
+func NewStack[T any]() (s *Stack[T]) {
+	return &Stack[T]{
+		data: []T{},
+	}
+}
+
+// Push adds an item to the top of the stack
+func (s *Stack[T]) Push(i T) {
+	s.data = append(s.data, i)
+}
+
+// Pop removes and returns the item at the top of the stack
+func (s *Stack[T]) Pop() (i T, err error) {
+	if len(s.data) == 0 {
+		return i, ErrEmptyStack
+	}
+	last := len(s.data) - 1
+	item := s.data[last]
+	// zero the vacated slot so the stack does not hold a reference to the popped item
+	var zero T
+	s.data[last] = zero
+	s.data = s.data[:last]
+	return item, nil
+}
+
+// Peek returns the item at the top of the stack without removing it
+func (s *Stack[T]) Peek() (i T, err error) {
+	if len(s.data) == 0 {
+		return i, ErrEmptyStack
+	}
+	return s.data[len(s.data)-1], nil
+}
+
+// Len returns the number of items in the stack
+func (s *Stack[T]) Len() int {
+	return len(s.data)
+}
+
+// IsEmpty evaluates if the stack is empty
+func (s *Stack[T]) IsEmpty() bool {
+	return len(s.data) == 0
+}
+
+// Clear removes all items from the stack
+func (s *Stack[T]) Clear() {
+	s.data = []T{}
+}
@@ -0,0 +1,81 @@
+package stack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPushPop(t *testing.T) {
+	tests := map[string]struct {
+		items          []int
+		expectedPopped []int
+	}{
+		"no items": {
+			items:          []int{},
+			expectedPopped: []int{},
+		},
+		"single item": {
+			items:          []int{1},
+			expectedPopped: []int{1},
+		},
+		"multiple items": {
+			items:          []int{3, 1, 2},
+			expectedPopped: []int{2, 1, 3},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			s := NewStack[int]()
+			for _, item := range test.items {
+				s.Push(item)
+			}
+			a.Equal(len(test.items), s.Len())
+
+			popped := []int{}
+			for {
+				item, err := s.Pop()
+				if err == ErrEmptyStack {
+					break
+				}
+				popped = append(popped, item)
+			}
+			a.Equal(test.expectedPopped, popped)
+			a.True(s.IsEmpty())
+		})
+	}
+}
+
+func TestPeek(t *testing.T) {
+	t.Run("empty stack", func(t *testing.T) {
+		s := NewStack[string]()
+		_, err := s.Peek()
+		assert.Equal(t, ErrEmptyStack, err)
+	})
+
+	t.Run("nonempty stack", func(t *testing.T) {
+		a := assert.New(t)
+		s := NewStack[string]()
+		s.Push("a")
+		s.Push("b")
+		item, err := s.Peek()
+		a.NoError(err)
+		a.Equal("b", item)
+		a.Equal(2, s.Len())
+	})
+}
+
+func TestClear(t *testing.T) {
+	a := assert.New(t)
+	s := NewStack[int]()
+	s.Push(1)
+	s.Push(2)
+	s.Clear()
+	a.True(s.IsEmpty())
+	a.Equal(0, s.Len())
+
+	_, err := s.Pop()
+	a.Equal(ErrEmptyStack, err)
+}
@@ -0,0 +1,274 @@
+package treap
+
+import (
+	"cmp"
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/dkaslovsky/search-structures/queue"
+)
+
+// Errors returned from a Treap
+var (
+	ErrEmpty        error = errors.New("Treap is empty")
+	ErrKeyNotFound  error = errors.New("key not found in Treap")
+	ErrMergeOrder   error = errors.New("cannot merge Treap with keys not all greater than the keys of the receiver")
+	ErrIteratorStop error = errors.New("iterator stopped after iterating all nodes")
+)
+
+// Treap is a randomized binary search tree whose nodes are additionally heap-ordered by randomly
+// drawn priorities, giving an expected depth of O(log n) without any rebalancing bookkeeping
+type Treap[K any, V any] struct {
+	Tree *Node[K, V]
+	cmp  func(a, b K) int
+	r    *rand.Rand
+}
+
+// NewTreap constructs an empty Treap ordered by the natural ordering of its keys
+func NewTreap[K cmp.Ordered, V any](opts ...Option) *Treap[K, V] {
+	return NewTreapFunc[K, V](cmp.Compare[K], opts...)
+}
+
+// NewTreapFunc constructs an empty Treap ordered by a comparison function that returns a negative
+// number when a < b, a positive number when a > b, and zero when a == b
+func NewTreapFunc[K any, V any](cmp func(a, b K) int, opts ...Option) *Treap[K, V] {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.r == nil {
+		o.r = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	return &Treap[K, V]{
+		cmp: cmp,
+		r:   o.r,
+	}
+}
+
+// Node is a node of a Treap indexed by Key containing value Val
+type Node[K any, V any] struct {
+	Key      K
+	Val      V
+	Left     *Node[K, V]
+	Right    *Node[K, V]
+	priority int64
+}
+
+// Priority returns the randomly drawn heap priority of a node
+func (n *Node[K, V]) Priority() int64 {
+	return n.priority
+}
+
+// IsEmpty evaluates if a Treap is empty
+func (t *Treap[K, V]) IsEmpty() bool {
+	return t.Tree == nil
+}
+
+// Insert inserts a key/value pair
+func (t *Treap[K, V]) Insert(key K, val V) {
+	if target, found := t.search(key); found {
+		// allow an existing value to be overwritten
+		target.Val = val
+		return
+	}
+
+	node := &Node[K, V]{
+		Key:      key,
+		Val:      val,
+		priority: t.r.Int63(),
+	}
+	left, right := t.split(t.Tree, key)
+	t.Tree = merge(merge(left, node), right)
+}
+
+// Delete deletes a key/value pair
+func (t *Treap[K, V]) Delete(key K) error {
+	if t.IsEmpty() {
+		return ErrEmpty
+	}
+
+	tree, found := t.delete(t.Tree, key)
+	if !found {
+		return ErrKeyNotFound
+	}
+	t.Tree = tree
+	return nil
+}
+
+// Search searches a Treap for a key
+func (t *Treap[K, V]) Search(key K) (val V, found bool) {
+	target, found := t.search(key)
+	if !found {
+		return val, false
+	}
+	return target.Val, true
+}
+
+// Split splits a Treap at key, retaining the keys less than key and returning a new Treap
+// containing the keys greater than or equal to key
+func (t *Treap[K, V]) Split(key K) *Treap[K, V] {
+	left, right := t.split(t.Tree, key)
+	t.Tree = left
+	return &Treap[K, V]{
+		Tree: right,
+		cmp:  t.cmp,
+		r:    rand.New(rand.NewSource(t.r.Int63())),
+	}
+}
+
+// Merge moves all nodes of other into a Treap, leaving other empty; every key of other must be
+// greater than every key of the receiver
+func (t *Treap[K, V]) Merge(other *Treap[K, V]) error {
+	if t.IsEmpty() || other.IsEmpty() {
+		t.Tree = merge(t.Tree, other.Tree)
+		other.Tree = nil
+		return nil
+	}
+
+	if t.cmp(t.Tree.findRightMost().Key, other.Tree.findLeftMost().Key) >= 0 {
+		return ErrMergeOrder
+	}
+	t.Tree = merge(t.Tree, other.Tree)
+	other.Tree = nil
+	return nil
+}
+
+// Validate determines if a Treap satisfies both the binary search tree property on its keys and the
+// heap property on its priorities
+func (t *Treap[K, V]) Validate() (bool, error) {
+	if t.IsEmpty() {
+		return false, ErrEmpty
+	}
+	return t.validate(t.Tree, nil, nil), nil
+}
+
+// Iterator creates a function to iterate the nodes of the Treap by returning the next (breadth-first) node on each call
+func (t *Treap[K, V]) Iterator() func() (*Node[K, V], error) {
+	if t.IsEmpty() {
+		return func() (*Node[K, V], error) {
+			return nil, ErrIteratorStop
+		}
+	}
+
+	q := queue.NewQueue[*Node[K, V]]()
+	q.Push(t.Tree)
+	return func() (*Node[K, V], error) {
+		curT, err := q.Pop()
+		if err == queue.ErrEmptyQueue {
+			return nil, ErrIteratorStop
+		}
+		if curT.Left != nil {
+			q.Push(curT.Left)
+		}
+		if curT.Right != nil {
+			q.Push(curT.Right)
+		}
+		return curT, nil
+	}
+}
+
+// search searches for a key and returns the node and success bool
+func (t *Treap[K, V]) search(key K) (target *Node[K, V], found bool) {
+	curTree := t.Tree
+	for curTree != nil {
+		c := t.cmp(key, curTree.Key)
+		if c == 0 {
+			return curTree, true
+		}
+		if c < 0 {
+			curTree = curTree.Left
+			continue
+		}
+		curTree = curTree.Right
+	}
+	return target, false
+}
+
+// split splits the subtree rooted at n into subtrees with keys less than key and keys greater than
+// or equal to key
+func (t *Treap[K, V]) split(n *Node[K, V], key K) (left *Node[K, V], right *Node[K, V]) {
+	if n == nil {
+		return nil, nil
+	}
+	if t.cmp(n.Key, key) < 0 {
+		n.Right, right = t.split(n.Right, key)
+		return n, right
+	}
+	left, n.Left = t.split(n.Left, key)
+	return left, n
+}
+
+// delete deletes a key from the subtree rooted at n and returns the resulting subtree and success bool
+func (t *Treap[K, V]) delete(n *Node[K, V], key K) (*Node[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var found bool
+	c := t.cmp(key, n.Key)
+	switch {
+	case c < 0:
+		n.Left, found = t.delete(n.Left, key)
+	case c > 0:
+		n.Right, found = t.delete(n.Right, key)
+	default:
+		return merge(n.Left, n.Right), true
+	}
+	return n, found
+}
+
+// validate checks the subtree rooted at n against exclusive key bounds, where a nil bound indicates
+// that the subtree is unbounded on that side, and checks that no child outranks its parent
+func (t *Treap[K, V]) validate(n *Node[K, V], minKey *K, maxKey *K) bool {
+	if n == nil {
+		return true
+	}
+	if minKey != nil && t.cmp(n.Key, *minKey) <= 0 {
+		return false
+	}
+	if maxKey != nil && t.cmp(n.Key, *maxKey) >= 0 {
+		return false
+	}
+	if n.Left != nil && n.Left.priority > n.priority {
+		return false
+	}
+	if n.Right != nil && n.Right.priority > n.priority {
+		return false
+	}
+	return t.validate(n.Left, minKey, &n.Key) && t.validate(n.Right, &n.Key, maxKey)
+}
+
+// merge joins two subtrees, where every key of left is less than every key of right, by keeping the
+// node with the higher priority as the root at each step
+func merge[K any, V any](left *Node[K, V], right *Node[K, V]) *Node[K, V] {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+	if left.priority > right.priority {
+		left.Right = merge(left.Right, right)
+		return left
+	}
+	right.Left = merge(left, right.Left)
+	return right
+}
+
+func (n *Node[K, V]) findLeftMost() *Node[K, V] {
+	left := n
+	for left.Left != nil {
+		left = left.Left
+	}
+	return left
+}
+
+func (n *Node[K, V]) findRightMost() *Node[K, V] {
+	right := n
+	for right.Right != nil {
+		right = right.Right
+	}
+	return right
+}
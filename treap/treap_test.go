@@ -0,0 +1,380 @@
+package treap
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newSeededTreap constructs a Treap with a fixed seed so that priorities are deterministic
+func newSeededTreap(keys ...int) *Treap[int, string] {
+	t := NewTreap[int, string](WithSeed(1))
+	for _, key := range keys {
+		t.Insert(key, valFor(key))
+	}
+	return t
+}
+
+func inOrderKeys(n *Node[int, string]) []int {
+	if n == nil {
+		return []int{}
+	}
+	keys := append(inOrderKeys(n.Left), n.Key)
+	return append(keys, inOrderKeys(n.Right)...)
+}
+
+func TestInsert(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys   []int
+		expectedKeys []int
+	}{
+		"single insert": {
+			insertKeys:   []int{10},
+			expectedKeys: []int{10},
+		},
+		"sorted inserts": {
+			insertKeys:   []int{1, 2, 3, 4, 5, 6, 7, 8},
+			expectedKeys: []int{1, 2, 3, 4, 5, 6, 7, 8},
+		},
+		"unsorted inserts": {
+			insertKeys:   []int{20, 10, 30, 25, 40, 15},
+			expectedKeys: []int{10, 15, 20, 25, 30, 40},
+		},
+		"duplicate inserts": {
+			insertKeys:   []int{20, 10, 20, 10},
+			expectedKeys: []int{10, 20},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := newSeededTreap(test.insertKeys...)
+			a.Equal(test.expectedKeys, inOrderKeys(tree.Tree))
+
+			valid, err := tree.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestInsertOverwrite(t *testing.T) {
+	a := assert.New(t)
+	tree := newSeededTreap(10)
+	tree.Insert(10, "newVal10")
+	val, found := tree.Search(10)
+	a.True(found)
+	a.Equal("newVal10", val)
+}
+
+func TestInsertSortedDepth(t *testing.T) {
+	a := assert.New(t)
+	n := 1 << 12
+	tree := newSeededTreap()
+	for i := 0; i < n; i++ {
+		tree.Insert(i, valFor(i))
+	}
+
+	valid, err := tree.Validate()
+	a.NoError(err)
+	a.True(valid)
+
+	// expected depth is about 2*ln(n) ~ 1.39*log2(n); allow generous slack for randomness
+	a.Less(height(tree.Tree), 4*12)
+}
+
+func TestSearch(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys     []int
+		searchKey      int
+		expectedExists bool
+	}{
+		"empty tree": {
+			insertKeys:     []int{},
+			searchKey:      1,
+			expectedExists: false,
+		},
+		"tree without searchKey": {
+			insertKeys:     []int{10, 8, 12},
+			searchKey:      2,
+			expectedExists: false,
+		},
+		"tree with searchKey": {
+			insertKeys:     []int{10, 8, 12},
+			searchKey:      12,
+			expectedExists: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := newSeededTreap(test.insertKeys...)
+			val, exists := tree.Search(test.searchKey)
+			a.Equal(test.expectedExists, exists)
+			if !test.expectedExists {
+				return
+			}
+			a.Equal(valFor(test.searchKey), val)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys   []int
+		deleteKey    int
+		expectedKeys []int
+		expectedErr  error
+	}{
+		"empty tree": {
+			insertKeys:  []int{},
+			deleteKey:   1,
+			expectedErr: ErrEmpty,
+		},
+		"tree without deleteKey": {
+			insertKeys:  []int{10, 8, 12},
+			deleteKey:   1,
+			expectedErr: ErrKeyNotFound,
+		},
+		"single node tree": {
+			insertKeys:   []int{10},
+			deleteKey:    10,
+			expectedKeys: []int{},
+		},
+		"multi node tree": {
+			insertKeys:   []int{20, 10, 30, 25, 40, 15},
+			deleteKey:    20,
+			expectedKeys: []int{10, 15, 25, 30, 40},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := newSeededTreap(test.insertKeys...)
+			err := tree.Delete(test.deleteKey)
+			if test.expectedErr != nil {
+				a.Equal(test.expectedErr, err)
+				return
+			}
+			a.NoError(err)
+			a.Equal(test.expectedKeys, inOrderKeys(tree.Tree))
+
+			if tree.IsEmpty() {
+				return
+			}
+			valid, err := tree.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestSplit(t *testing.T) {
+	tests := map[string]struct {
+		insertKeys    []int
+		splitKey      int
+		expectedLeft  []int
+		expectedRight []int
+	}{
+		"empty tree": {
+			insertKeys:    []int{},
+			splitKey:      1,
+			expectedLeft:  []int{},
+			expectedRight: []int{},
+		},
+		"split at existing key": {
+			insertKeys:    []int{20, 10, 30, 25, 40, 15},
+			splitKey:      25,
+			expectedLeft:  []int{10, 15, 20},
+			expectedRight: []int{25, 30, 40},
+		},
+		"split at missing key": {
+			insertKeys:    []int{20, 10, 30, 25, 40, 15},
+			splitKey:      22,
+			expectedLeft:  []int{10, 15, 20},
+			expectedRight: []int{25, 30, 40},
+		},
+		"split below all keys": {
+			insertKeys:    []int{20, 10, 30},
+			splitKey:      1,
+			expectedLeft:  []int{},
+			expectedRight: []int{10, 20, 30},
+		},
+		"split above all keys": {
+			insertKeys:    []int{20, 10, 30},
+			splitKey:      31,
+			expectedLeft:  []int{10, 20, 30},
+			expectedRight: []int{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := newSeededTreap(test.insertKeys...)
+			right := tree.Split(test.splitKey)
+			a.Equal(test.expectedLeft, inOrderKeys(tree.Tree))
+			a.Equal(test.expectedRight, inOrderKeys(right.Tree))
+
+			for _, tr := range []*Treap[int, string]{tree, right} {
+				if tr.IsEmpty() {
+					continue
+				}
+				valid, err := tr.Validate()
+				a.NoError(err)
+				a.True(valid)
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	tests := map[string]struct {
+		leftKeys     []int
+		rightKeys    []int
+		expectedKeys []int
+		expectedErr  error
+	}{
+		"both empty": {
+			leftKeys:     []int{},
+			rightKeys:    []int{},
+			expectedKeys: []int{},
+		},
+		"empty receiver": {
+			leftKeys:     []int{},
+			rightKeys:    []int{1, 2},
+			expectedKeys: []int{1, 2},
+		},
+		"empty other": {
+			leftKeys:     []int{1, 2},
+			rightKeys:    []int{},
+			expectedKeys: []int{1, 2},
+		},
+		"ordered merge": {
+			leftKeys:     []int{10, 5, 15},
+			rightKeys:    []int{30, 20, 25},
+			expectedKeys: []int{5, 10, 15, 20, 25, 30},
+		},
+		"overlapping merge": {
+			leftKeys:    []int{10, 5, 15},
+			rightKeys:   []int{12, 20},
+			expectedErr: ErrMergeOrder,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			left := newSeededTreap(test.leftKeys...)
+			right := newSeededTreap(test.rightKeys...)
+			err := left.Merge(right)
+			if test.expectedErr != nil {
+				a.Equal(test.expectedErr, err)
+				return
+			}
+			a.NoError(err)
+			a.Equal(test.expectedKeys, inOrderKeys(left.Tree))
+			a.True(right.IsEmpty())
+
+			if left.IsEmpty() {
+				return
+			}
+			valid, err := left.Validate()
+			a.NoError(err)
+			a.True(valid)
+		})
+	}
+}
+
+func TestSeedOptions(t *testing.T) {
+	shape := func(tree *Treap[int, string]) [][2]int64 {
+		nodes := [][2]int64{}
+		next := tree.Iterator()
+		for {
+			node, err := next()
+			if err == ErrIteratorStop {
+				return nodes
+			}
+			nodes = append(nodes, [2]int64{int64(node.Key), node.Priority()})
+		}
+	}
+	build := func(opts ...Option) *Treap[int, string] {
+		tree := NewTreap[int, string](opts...)
+		for _, key := range rand.New(rand.NewSource(2)).Perm(100) {
+			tree.Insert(key, valFor(key))
+		}
+		return tree
+	}
+
+	a := assert.New(t)
+	expected := shape(build(WithSeed(7)))
+	a.Equal(expected, shape(build(WithSeed(7))))
+	a.Equal(expected, shape(build(WithRand(rand.New(rand.NewSource(7))))))
+	a.NotEqual(expected, shape(build(WithSeed(8))))
+}
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		tree          *Node[int, string]
+		expectedValid bool
+		expectedErr   error
+	}{
+		"empty tree": {
+			tree:          nil,
+			expectedValid: false,
+			expectedErr:   ErrEmpty,
+		},
+		"valid tree": {
+			tree: &Node[int, string]{
+				Key:      10,
+				Left:     &Node[int, string]{Key: 8, priority: 1},
+				Right:    &Node[int, string]{Key: 12, priority: 2},
+				priority: 3,
+			},
+			expectedValid: true,
+		},
+		"tree violating key ordering": {
+			tree: &Node[int, string]{
+				Key:      10,
+				Left:     &Node[int, string]{Key: 11, priority: 1},
+				priority: 3,
+			},
+			expectedValid: false,
+		},
+		"tree violating heap ordering": {
+			tree: &Node[int, string]{
+				Key:      10,
+				Right:    &Node[int, string]{Key: 12, priority: 4},
+				priority: 3,
+			},
+			expectedValid: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			tree := NewTreap[int, string]()
+			tree.Tree = test.tree
+			valid, err := tree.Validate()
+			a.Equal(test.expectedValid, valid)
+			a.Equal(test.expectedErr, err)
+		})
+	}
+}
+
+func height(n *Node[int, string]) int {
+	if n == nil {
+		return 0
+	}
+	return max(height(n.Left), height(n.Right)) + 1
+}
+
+func valFor(key int) string {
+	return fmt.Sprintf("val%d", key)
+}